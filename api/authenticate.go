@@ -13,15 +13,30 @@ import (
 // token must be included in every request to the service, in the Cookie: request header.
 // The client making the API call must examine the cookie expiry time and discard it if the cookie has expired.
 // Requests sent with expired cookies will result in an Unauthorized error in the response.
+// The password is taken from the credential provider of the client.
 func (c *Client) Authenticate() (err error) {
 	if c.isAuth() {
 		return ErrAlreadyAuth
 	}
 
+	// a client that was not built with NewClient has no provider
+	if c.credentials == nil {
+		return ErrNoCredentials
+	}
+
+	password, err := c.credentials.Password(Account{
+		Endpoint: c.endpoint,
+		Identify: c.identify,
+		Username: c.username,
+	})
+	if err != nil {
+		return err
+	}
+
 	// build the json authentication
 	auth := map[string]string{
 		"user":     fmt.Sprintf("/Compute-%s/%s", c.identify, c.username),
-		"password": c.password,
+		"password": password,
 	}
 
 	return request(paramsRequest{
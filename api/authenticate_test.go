@@ -0,0 +1,56 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type authenticateTest struct{}
+
+var _ = gc.Suite(&authenticateTest{})
+
+func (a authenticateTest) TestAuthenticate(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.AllSSHKeyDetails()
+	c.Assert(err, gc.Equals, api.ErrNotAuth)
+
+	c.Assert(cli.Authenticate(), gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.Equals, api.ErrAlreadyAuth)
+
+	_, err = cli.AllSSHKeyDetails()
+	c.Assert(err, gc.IsNil)
+
+	c.Assert(cli.RefreshCookie(), gc.IsNil)
+
+	_, err = cli.AllSSHKeyDetails()
+	c.Assert(err, gc.IsNil)
+}
+
+func (a authenticateTest) TestAuthenticateWrongPassword(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	cfg := server.Config()
+	cfg.Password = "wrong"
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+
+	err = cli.Authenticate()
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Error api response 401 Incorrect username or password")
+}
+
+func (a authenticateTest) TestAuthenticateZeroClient(c *gc.C) {
+	var cli api.Client
+	c.Assert(cli.Authenticate(), gc.Equals, api.ErrNoCredentials)
+}
@@ -0,0 +1,44 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	gc "gopkg.in/check.v1"
+)
+
+type backupTest struct{}
+
+var _ = gc.Suite(&backupTest{})
+
+func (b backupTest) TestBackupConfiguration(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateBackupConfiguration(api.BackupConfigurationParams{
+		Name:                 "daily",
+		BackupRetentionCount: 2,
+		Enabled:              true,
+		VolumeUri:            server.URL + "/storage/volume/Compute-myIdentify/oracleusername@oracle.com/data",
+		Interval: map[string]interface{}{
+			"Hourly": map[string]int{"hourlyInterval": 2},
+		},
+	})
+	c.Assert(err, gc.IsNil)
+
+	all, err := cli.AllBackupConfiguration()
+	c.Assert(err, gc.IsNil)
+	c.Assert(all, gc.HasLen, 1)
+	c.Assert(all[0].BackupRetentionCount, gc.Equals, uint32(2))
+
+	details, err := cli.BackupConfigurationDetails("daily")
+	c.Assert(err, gc.IsNil)
+	c.Assert(details.Name, gc.Equals, "daily")
+
+	c.Assert(cli.DeleteBackupConfiguration("daily"), gc.IsNil)
+
+	all, err = cli.AllBackupConfiguration()
+	c.Assert(err, gc.IsNil)
+	c.Assert(all, gc.HasLen, 0)
+}
@@ -0,0 +1,169 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// DefaultBulkWorkers is the number of concurrent requests
+// that the bulk helpers make if the workers given is < 1
+const DefaultBulkWorkers = 8
+
+// bulk calls fetch for every index from 0 to n-1 using at most
+// workers goroutines. If the context is done the remaining indexes
+// are passed to skip with the error of the context instead.
+// The context could be nil, like in the iterators.
+func bulk(
+	ctx context.Context,
+	n int,
+	workers int,
+	fetch func(i int),
+	skip func(i int, err error),
+) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if workers < 1 {
+		workers = DefaultBulkWorkers
+	}
+	if workers > n {
+		workers = n
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					skip(i, err)
+					continue
+				}
+				fetch(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// InstanceResult is the result of fetching the details of an instance
+type InstanceResult struct {
+	// Name is the name of the instance
+	Name string
+
+	// Instance holds the details of the instance if Err is nil
+	Instance response.Instance
+
+	// Err is the error of fetching the details, if any
+	Err error
+}
+
+// BulkInstanceDetails retrieves the details of the given instances
+// concurrently, using at most workers requests at a time. A failed
+// request doesn't abort the others, every result has its own error.
+// The results are in the same order as the names.
+func (c Client) BulkInstanceDetails(
+	ctx context.Context,
+	names []string,
+	workers int,
+) []InstanceResult {
+
+	results := make([]InstanceResult, len(names))
+	bulk(ctx, len(names), workers,
+		func(i int) {
+			instance, err := c.instanceDetails(ctx, names[i])
+			results[i] = InstanceResult{Name: names[i], Instance: instance, Err: err}
+		},
+		func(i int, err error) {
+			results[i] = InstanceResult{Name: names[i], Err: err}
+		},
+	)
+
+	return results
+}
+
+// VirtualNicResult is the result of fetching the details of a virtual nic
+type VirtualNicResult struct {
+	// Name is the name of the virtual nic
+	Name string
+
+	// VirtualNic holds the details of the virtual nic if Err is nil
+	VirtualNic response.VirtualNic
+
+	// Err is the error of fetching the details, if any
+	Err error
+}
+
+// BulkVirtualNic retrieves the details of the given virtual nics
+// concurrently, using at most workers requests at a time. A failed
+// request doesn't abort the others, every result has its own error.
+// The results are in the same order as the names.
+func (c Client) BulkVirtualNic(
+	ctx context.Context,
+	names []string,
+	workers int,
+) []VirtualNicResult {
+
+	results := make([]VirtualNicResult, len(names))
+	bulk(ctx, len(names), workers,
+		func(i int) {
+			vnic, err := c.virtualNic(ctx, names[i])
+			results[i] = VirtualNicResult{Name: names[i], VirtualNic: vnic, Err: err}
+		},
+		func(i int, err error) {
+			results[i] = VirtualNicResult{Name: names[i], Err: err}
+		},
+	)
+
+	return results
+}
+
+// StorageVolumeResult is the result of fetching
+// the details of a storage volume
+type StorageVolumeResult struct {
+	// Name is the name of the storage volume
+	Name string
+
+	// StorageVolume holds the details of the volume if Err is nil
+	StorageVolume response.StorageVolume
+
+	// Err is the error of fetching the details, if any
+	Err error
+}
+
+// BulkStorageVolumeDetails retrieves the details of the given storage
+// volumes concurrently, using at most workers requests at a time.
+// A failed request doesn't abort the others, every result has its
+// own error. The results are in the same order as the names.
+func (c Client) BulkStorageVolumeDetails(
+	ctx context.Context,
+	names []string,
+	workers int,
+) []StorageVolumeResult {
+
+	results := make([]StorageVolumeResult, len(names))
+	bulk(ctx, len(names), workers,
+		func(i int) {
+			volume, err := c.storageVolumeDetails(ctx, names[i])
+			results[i] = StorageVolumeResult{Name: names[i], StorageVolume: volume, Err: err}
+		},
+		func(i int, err error) {
+			results[i] = StorageVolumeResult{Name: names[i], Err: err}
+		},
+	)
+
+	return results
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type bulkTest struct{}
+
+var _ = gc.Suite(&bulkTest{})
+
+func (b bulkTest) TestBulkStorageVolumeDetails(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	var names []string
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("volume%d", i)
+		_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+			Name:       name,
+			Size:       "10G",
+			Properties: []string{"/oracle/public/storage/default"},
+		})
+		c.Assert(err, gc.IsNil)
+		names = append(names, name)
+	}
+
+	names = append(names, "missing")
+	server.Inject(oracletest.InternalError("GET",
+		"/storage/volume/Compute-myIdentify/oracleusername@oracle.com/volume7", "ref-7"))
+
+	results := cli.BulkStorageVolumeDetails(context.Background(), names, 4)
+	c.Assert(results, gc.HasLen, len(names))
+
+	for i, result := range results {
+		c.Assert(result.Name, gc.Equals, names[i])
+		switch result.Name {
+		case "volume7":
+			c.Assert(result.Err, gc.ErrorMatches, ".* 500 .*")
+		case "missing":
+			c.Assert(result.Err, gc.ErrorMatches, ".* 404 .*")
+		default:
+			c.Assert(result.Err, gc.IsNil)
+			c.Assert(result.StorageVolume.Name, gc.Equals, result.Name)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results = cli.BulkStorageVolumeDetails(ctx, names[:3], 0)
+	for _, result := range results {
+		c.Assert(result.Err, gc.Equals, context.Canceled)
+	}
+}
+
+func (b bulkTest) TestBulkCancelInFlight(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "volume",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	})
+	c.Assert(err, gc.IsNil)
+
+	server.Inject(oracletest.Fault{
+		Method: "GET",
+		Path:   "/storage/volume/",
+		Delay:  time.Second,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// the requests in flight are cancelled with the context
+	start := time.Now()
+	results := cli.BulkStorageVolumeDetails(ctx, []string{"volume", "volume"}, 2)
+	c.Assert(time.Since(start) < 500*time.Millisecond, gc.Equals, true)
+	for _, result := range results {
+		c.Assert(result.Err, gc.ErrorMatches, ".*context deadline exceeded.*")
+	}
+}
+
+func (b bulkTest) TestBulkNilContext(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "volume",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	})
+	c.Assert(err, gc.IsNil)
+
+	results := cli.BulkStorageVolumeDetails(nil, []string{"volume"}, 1)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Err, gc.IsNil)
+	c.Assert(results[0].StorageVolume.Name, gc.Equals, "volume")
+}
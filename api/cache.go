@@ -0,0 +1,333 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache is a read-through cache of the responses of the GET
+// requests of a client, enabled with Config.Cache. Every resource
+// kind, like shape or instance, has its own time to live and all
+// the cached responses of a kind are invalidated when the client
+// creates, updates or deletes an object of that kind.
+// The lists of the iterators, like ListInstances, are streamed
+// and never cached.
+type Cache struct {
+	// DefaultTTL is the time to live of the resource kinds
+	// that are not in TTLs. If it's 0 they are not cached.
+	DefaultTTL time.Duration
+
+	// TTLs holds the time to live of the resource kinds,
+	// like shape, instance or network/v1/ipnetwork.
+	// A kind with a TTL of 0 is not cached.
+	// Once the cache is in use, change it only with SetTTL.
+	TTLs map[string]time.Duration
+
+	// Now if it's not nil returns the current time used to
+	// expire the responses, instead of time.Now
+	Now func() time.Time
+
+	mu            sync.Mutex
+	entries       map[string]cacheEntry
+	hits          uint64
+	misses        uint64
+	invalidations uint64
+	// generation is changed on every invalidation, so
+	// the responses of the requests that were in flight
+	// during an invalidation are not cached
+	generation uint64
+}
+
+// CacheStats are the statistics of a cache
+type CacheStats struct {
+	// Hits is the number of requests answered from the cache
+	Hits uint64
+
+	// Misses is the number of cacheable requests
+	// that were made to the api
+	Misses uint64
+
+	// Invalidations is the number of cached responses that
+	// were dropped because their resource kind was changed
+	Invalidations uint64
+
+	// Entries is the number of responses in the cache
+	Entries int
+}
+
+// cacheEntry is a cached response
+type cacheEntry struct {
+	kind    string
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// NewCache returns a cache with long TTLs for the resources that
+// rarely change, like the shapes and the storage properties,
+// and a short TTL for the instances
+func NewCache() *Cache {
+	return &Cache{
+		TTLs: map[string]time.Duration{
+			"shape":            time.Hour,
+			"property/storage": time.Hour,
+			"account":          10 * time.Minute,
+			"imagelist":        10 * time.Minute,
+			"instance":         5 * time.Second,
+		},
+	}
+}
+
+// Stats returns the statistics of the cache
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Invalidations: c.invalidations,
+		Entries:       len(c.entries),
+	}
+}
+
+// Purge drops all the cached responses
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = nil
+	c.generation++
+}
+
+// SetTTL sets the time to live of the resource kind.
+// It's safe to call it while the cache is in use.
+func (c *Cache) SetTTL(kind string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ttls := make(map[string]time.Duration, len(c.TTLs)+1)
+	for k, v := range c.TTLs {
+		ttls[k] = v
+	}
+	ttls[kind] = ttl
+	c.TTLs = ttls
+}
+
+// cacheable returns true if the responses of the kind are cached
+// and the generation that the response should be put with
+func (c *Cache) cacheable(kind string) (bool, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ttl(kind) > 0, c.generation
+}
+
+// ttl returns the time to live of the kind.
+// It should be called with the lock held.
+func (c *Cache) ttl(kind string) time.Duration {
+	if ttl, ok := c.TTLs[kind]; ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+// clock returns the current time
+func (c *Cache) clock() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// get returns the fresh cached response of the key
+func (c *Cache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if ok && c.clock().Before(entry.expires) {
+		c.hits++
+		return entry, true
+	}
+
+	if ok {
+		delete(c.entries, key)
+	}
+	c.misses++
+	return cacheEntry{}, false
+}
+
+// put caches the response of the key, unless the cache
+// was invalidated after the generation of the request
+func (c *Cache) put(key string, entry cacheEntry, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if c.entries == nil {
+		c.entries = make(map[string]cacheEntry)
+	}
+	entry.expires = c.clock().Add(c.ttl(entry.kind))
+	c.entries[key] = entry
+}
+
+// invalidate drops all the cached responses of the kinds
+func (c *Cache) invalidate(kinds ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key, entry := range c.entries {
+		for _, kind := range kinds {
+			if entry.kind == kind {
+				delete(c.entries, key)
+				c.invalidations++
+				break
+			}
+		}
+	}
+}
+
+// invalidates holds the other kinds whose objects are
+// changed by the requests on a kind
+var invalidates = map[string][]string{
+	"launchplan":            {"instance"},
+	"rebootinstancerequest": {"instance"},
+	"ip/ipassociation":      {"ip/association"},
+}
+
+// resourceKind returns the resource kind of the request path,
+// that are the path elements before the container of the object,
+// like instance for /instance/Compute-identify/user/name
+func resourceKind(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, "Compute-") || part == "oracle" {
+			return strings.Join(parts[:i], "/")
+		}
+	}
+
+	// objects that are not in a container, like /shape/oc3
+	if !strings.HasSuffix(path, "/") && len(parts) > 1 {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, "/")
+}
+
+// cachingTransport answers the GET requests from the cache
+// and invalidates it on the requests that change objects
+type cachingTransport struct {
+	cache *Cache
+	next  http.RoundTripper
+}
+
+// RoundTrip answers the request from the cache or makes it
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	kind := resourceKind(req.URL.Path)
+
+	switch req.Method {
+	case "GET":
+		if streamed(req.Context()) {
+			// buffering the body would defeat the streaming
+			return next.RoundTrip(req)
+		}
+	case "POST", "PUT", "DELETE":
+		resp, err := next.RoundTrip(req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			t.cache.invalidate(append([]string{kind}, invalidates[kind]...)...)
+		}
+		return resp, err
+	default:
+		return next.RoundTrip(req)
+	}
+
+	cacheable, generation := t.cache.cacheable(kind)
+	if !cacheable {
+		return next.RoundTrip(req)
+	}
+
+	// the directory listings are cached apart
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+	if entry, ok := t.cache.get(key); ok {
+		return entry.response(req), nil
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := cacheEntry{
+		kind:   kind,
+		status: resp.StatusCode,
+		header: resp.Header,
+		body:   body,
+	}
+	t.cache.put(key, entry, generation)
+
+	return entry.response(req), nil
+}
+
+// streamKey is the context key that marks the requests
+// whose response is streamed by the iterators
+type streamKey struct{}
+
+// withStream returns ctx, or the background context if it's nil,
+// marked so the cache doesn't buffer the response of the request
+func withStream(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, streamKey{}, true)
+}
+
+// streamed returns true if the request of the ctx is streamed
+func streamed(ctx context.Context) bool {
+	stream, _ := ctx.Value(streamKey{}).(bool)
+	return stream
+}
+
+// response returns a new response with the cached content
+func (e cacheEntry) response(req *http.Request) *http.Response {
+	header := make(http.Header, len(e.header))
+	for k, v := range e.header {
+		header[k] = append([]string(nil), v...)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	gc "gopkg.in/check.v1"
+)
+
+type cachingTransportTest struct{}
+
+var _ = gc.Suite(&cachingTransportTest{})
+
+// roundTripFunc is an http.RoundTripper made of a func
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func (t cachingTransportTest) TestCachedStatus(c *gc.C) {
+	transport := &cachingTransport{
+		cache: NewCache(),
+		next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":[]}`)),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest("GET", "https://api.oracle.com/shape/", nil)
+		c.Assert(err, gc.IsNil)
+
+		resp, err := transport.RoundTrip(req)
+		c.Assert(err, gc.IsNil)
+		c.Assert(resp.Status, gc.Equals, "200 OK")
+		c.Assert(resp.StatusCode, gc.Equals, http.StatusOK)
+
+		body, err := ioutil.ReadAll(resp.Body)
+		c.Assert(err, gc.IsNil)
+		c.Assert(string(body), gc.Equals, `{"result":[]}`)
+	}
+
+	c.Assert(transport.cache.Stats().Hits, gc.Equals, uint64(1))
+}
@@ -0,0 +1,252 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type cacheTest struct{}
+
+var _ = gc.Suite(&cacheTest{})
+
+// newCachedClient returns a client authenticated against
+// the server that caches the responses in the cache
+func newCachedClient(c *gc.C, cache *api.Cache) (*oracletest.Server, *api.Client) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+
+	cfg := server.Config()
+	cfg.Cache = cache
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	return server, cli
+}
+
+func (t cacheTest) TestReadThrough(c *gc.C) {
+	cache := api.NewCache()
+	server, cli := newCachedClient(c, cache)
+	defer server.Close()
+
+	first, err := cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+
+	second, err := cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+	c.Assert(second, gc.DeepEquals, first)
+
+	_, err = cli.ShapeDetails("oc3")
+	c.Assert(err, gc.IsNil)
+
+	c.Assert(cache.Stats(), gc.DeepEquals, api.CacheStats{
+		Hits:    1,
+		Misses:  2,
+		Entries: 2,
+	})
+
+	cache.Purge()
+	c.Assert(cache.Stats().Entries, gc.Equals, 0)
+}
+
+func (t cacheTest) TestInvalidation(c *gc.C) {
+	cache := api.NewCache()
+	cache.SetTTL("storage/volume", time.Hour)
+	server, cli := newCachedClient(c, cache)
+	defer server.Close()
+
+	create := func(name string) {
+		_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+			Name:       name,
+			Size:       "10G",
+			Properties: []string{"/oracle/public/storage/default"},
+		})
+		c.Assert(err, gc.IsNil)
+	}
+
+	create("volume1")
+	volumes, err := cli.AllStorageVolume()
+	c.Assert(err, gc.IsNil)
+	c.Assert(volumes.Result, gc.HasLen, 1)
+
+	create("volume2")
+	volumes, err = cli.AllStorageVolume()
+	c.Assert(err, gc.IsNil)
+	c.Assert(volumes.Result, gc.HasLen, 2)
+
+	// the storage properties are read from the cache
+	// when the second volume is created
+	stats := cache.Stats()
+	c.Assert(stats.Hits, gc.Equals, uint64(1))
+	c.Assert(stats.Invalidations, gc.Equals, uint64(1))
+
+	_, err = cli.AllStorageVolume()
+	c.Assert(err, gc.IsNil)
+	c.Assert(cache.Stats().Hits, gc.Equals, uint64(2))
+
+	c.Assert(cli.DeleteStorageVolume("volume1"), gc.IsNil)
+	volumes, err = cli.AllStorageVolume()
+	c.Assert(err, gc.IsNil)
+	c.Assert(volumes.Result, gc.HasLen, 1)
+}
+
+func (t cacheTest) TestExpire(c *gc.C) {
+	clock := oracletest.NewManualClock(time.Now())
+	cache := &api.Cache{DefaultTTL: time.Minute, Now: clock.Now}
+	server, cli := newCachedClient(c, cache)
+	defer server.Close()
+
+	_, err := cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+
+	clock.Advance(59 * time.Second)
+	_, err = cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+
+	clock.Advance(time.Second)
+	_, err = cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+
+	stats := cache.Stats()
+	c.Assert(stats.Hits, gc.Equals, uint64(1))
+	c.Assert(stats.Misses, gc.Equals, uint64(2))
+}
+
+// holdTransport holds the response of the first GET
+// request to the path until it's released
+type holdTransport struct {
+	path     string
+	arrived  chan struct{}
+	released chan struct{}
+}
+
+func (h *holdTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if req.Method == "GET" && req.URL.Path == h.path && h.arrived != nil {
+		close(h.arrived)
+		h.arrived = nil
+		<-h.released
+	}
+	return resp, err
+}
+
+func (t cacheTest) TestInFlightInvalidation(c *gc.C) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+	defer server.Close()
+
+	hold := &holdTransport{
+		path:     "/storage/volume/Compute-myIdentify/oracleusername@oracle.com/",
+		arrived:  make(chan struct{}),
+		released: make(chan struct{}),
+	}
+	arrived := hold.arrived
+
+	cache := api.NewCache()
+	cache.SetTTL("storage/volume", time.Hour)
+
+	cfg := server.Config()
+	cfg.Cache = cache
+	cfg.Transport = hold
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	create := func(name string) {
+		_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+			Name:       name,
+			Size:       "10G",
+			Properties: []string{"/oracle/public/storage/default"},
+		})
+		c.Assert(err, gc.IsNil)
+	}
+
+	create("volume1")
+
+	// the list is read before the second volume is created
+	// but its response arrives after the invalidation
+	done := make(chan int)
+	go func() {
+		volumes, _ := cli.AllStorageVolume()
+		done <- len(volumes.Result)
+	}()
+
+	<-arrived
+	create("volume2")
+	close(hold.released)
+	c.Assert(<-done, gc.Equals, 1)
+
+	volumes, err := cli.AllStorageVolume()
+	c.Assert(err, gc.IsNil)
+	c.Assert(volumes.Result, gc.HasLen, 2)
+}
+
+func (t cacheTest) TestRebootInvalidatesInstances(c *gc.C) {
+	cache := api.NewCache()
+	cache.SetTTL("instance", time.Hour)
+	server, cli := newCachedClient(c, cache)
+	defer server.Close()
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.IsNil)
+
+	all, err := cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+	c.Assert(all.Result, gc.HasLen, 1)
+
+	_, err = cli.CreateRebootInstanceRequest(false, all.Result[0].Name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cache.Stats().Invalidations, gc.Equals, uint64(1))
+}
+
+func (t cacheTest) TestIteratorsNotCached(c *gc.C) {
+	cache := api.NewCache()
+	server, cli := newCachedClient(c, cache)
+	defer server.Close()
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.IsNil)
+
+	for i := 0; i < 2; i++ {
+		it := cli.ListInstances(context.Background())
+		n := 0
+		for it.Next() {
+			n++
+		}
+		c.Assert(it.Err(), gc.IsNil)
+		c.Assert(n, gc.Equals, 1)
+	}
+
+	c.Assert(cache.Stats(), gc.DeepEquals, api.CacheStats{})
+
+	// the same list without the iterator is cached
+	_, err = cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+	c.Assert(cache.Stats().Entries, gc.Equals, 1)
+}
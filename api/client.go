@@ -19,11 +19,31 @@ type Config struct {
 	// Username will hold the username oracle cloud client account
 	Username string
 
-	// Password will be the password of the orcale cloud client account
+	// Password will be the password of the orcale cloud client account.
+	// It's not needed if Credentials is set.
 	Password string
 
+	// Credentials if it's not nil provides the password of the account
+	// when the client authenticates, instead of the Password
+	Credentials CredentialProvider
+
 	// Endpoint will hold the base url endpoint of the oracle cloud api
 	Endpoint string
+
+	// ValidateIpNetworkExchange if it's true, CreateIp and UpdateIp
+	// will check that the ip network exchange given exists
+	// before creating or updating the ip network
+	ValidateIpNetworkExchange bool
+
+	// Transport if it's not nil is used by the client to make
+	// the http requests, instead of the http.DefaultTransport.
+	// It could be used to record and replay the api interactions
+	// in tests, see the oracletest package.
+	Transport http.RoundTripper
+
+	// Cache if it's not nil caches the responses of the GET
+	// requests of the client, see NewCache
+	Cache *Cache
 }
 
 func (c Config) validate() error {
@@ -35,7 +55,7 @@ func (c Config) validate() error {
 		return errors.New("go-oracle-cloud: Empty client username")
 	}
 
-	if c.Password == "" {
+	if c.Password == "" && c.Credentials == nil {
 		return errors.New("go-oracle-cloud: Empty client password")
 	}
 
@@ -53,15 +73,15 @@ func (c Config) validate() error {
 // Client holds the client credentials of the clients
 // oracle cloud.
 // The client needs identify name, user name and
-// password in order to comunicate with the oracle
-// cloud provider
+// a credential provider of the password in order
+// to comunicate with the oracle cloud provider
 type Client struct {
 	// identify the intentity endpoint
 	identify string
 	// the username of the oracle account
 	username string
-	// the password of the oracle account
-	password string
+	// provides the password of the oracle account
+	credentials CredentialProvider
 	// internal http cookie
 	// this cookie will be generated based on the client connection
 	cookie *http.Cookie
@@ -69,6 +89,9 @@ type Client struct {
 	endpoint string
 	// internal http client
 	http http.Client
+	// check if the ip network exchange exists before
+	// using it in the ip network requests
+	validateExchange bool
 }
 
 // NewClient returns a new client based on the cfg provided
@@ -78,12 +101,24 @@ func NewClient(cfg Config) (*Client, error) {
 		return nil, err
 	}
 
+	transport := cfg.Transport
+	if cfg.Cache != nil {
+		transport = &cachingTransport{cache: cfg.Cache, next: transport}
+	}
+
+	credentials := cfg.Credentials
+	if credentials == nil {
+		credentials = StaticCredentials(cfg.Password)
+	}
+
 	cli := &Client{
-		identify: cfg.Identify,
-		username: cfg.Username,
-		password: cfg.Password,
-		endpoint: cfg.Endpoint,
-		http:     http.Client{},
+		identify:    cfg.Identify,
+		username:    cfg.Username,
+		credentials: credentials,
+		endpoint:    cfg.Endpoint,
+		http:        http.Client{Transport: transport},
+
+		validateExchange: cfg.ValidateIpNetworkExchange,
 	}
 
 	return cli, nil
@@ -0,0 +1,290 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// ErrNoCredentials is returned by the credential providers
+// that don't have the password of the account, the chain
+// provider tries the next provider when it's returned
+var ErrNoCredentials = errors.New("go-oracle-cloud: No credentials found")
+
+// Account is the oracle cloud account that
+// the client needs the password for
+type Account struct {
+	// Endpoint is the base url endpoint of the oracle cloud api
+	Endpoint string
+
+	// Identify is the identify endpoint name of the account
+	Identify string
+
+	// Username is the username of the account
+	Username string
+}
+
+// CredentialProvider provides the password of an oracle cloud account.
+// The client asks the provider for the password every time it
+// authenticates and doesn't keep it after that.
+type CredentialProvider interface {
+	// Password returns the password of the account or
+	// ErrNoCredentials if the provider doesn't have it
+	Password(account Account) (string, error)
+}
+
+// StaticCredentials is a provider that returns the same password
+// for all the accounts. NewClient uses it for the Config.Password.
+type StaticCredentials string
+
+// Password returns the static password
+func (s StaticCredentials) Password(account Account) (string, error) {
+	if s == "" {
+		return "", ErrNoCredentials
+	}
+	return string(s), nil
+}
+
+// EnvCredentials is a provider that returns the password
+// from the OPC_PASSWORD environment variable
+type EnvCredentials struct{}
+
+// Password returns the password that is in the environment
+func (e EnvCredentials) Password(account Account) (string, error) {
+	password := os.Getenv(EnvPassword)
+	if password == "" {
+		return "", ErrNoCredentials
+	}
+	return password, nil
+}
+
+// CommandCredentials is a provider that runs an external command
+// in the same way as the git credential helpers. The command gets
+// on its standard input the account in the form
+//
+//	protocol=https
+//	host=api-z52.compute.us2.oraclecloud.com
+//	username=/Compute-qbitq/oracle@username.com
+//
+// and it should write the password on its standard output
+// as a password=<password> line.
+type CommandCredentials struct {
+	// Name is the name of the command
+	Name string
+
+	// Args are the arguments of the command
+	Args []string
+}
+
+// Password runs the command and returns the password that it writes
+func (c CommandCredentials) Password(account Account) (string, error) {
+	if c.Name == "" {
+		return "", errors.New("go-oracle-cloud: Empty credential command")
+	}
+
+	var input bytes.Buffer
+	if u, err := url.Parse(account.Endpoint); err == nil && u.Host != "" {
+		fmt.Fprintf(&input, "protocol=%s\nhost=%s\n", u.Scheme, u.Host)
+	}
+	fmt.Fprintf(&input, "username=/Compute-%s/%s\n\n",
+		account.Identify, account.Username)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(c.Name, c.Args...)
+	cmd.Stdin = &input
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Credential command %s failed: %v %s",
+			c.Name, err, strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "password=") {
+			if password := strings.TrimPrefix(line, "password="); password != "" {
+				return password, nil
+			}
+		}
+	}
+
+	return "", ErrNoCredentials
+}
+
+// ChainCredentials is a provider that asks the providers in order
+// and returns the first password found. It stops at the first
+// provider that fails with other error than ErrNoCredentials.
+type ChainCredentials []CredentialProvider
+
+// Password returns the first password that a provider has
+func (c ChainCredentials) Password(account Account) (string, error) {
+	for _, provider := range c {
+		password, err := provider.Password(account)
+		if err == ErrNoCredentials {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return password, nil
+	}
+
+	return "", ErrNoCredentials
+}
+
+// DefaultKeyIterations is the number of pbkdf2 iterations used
+// to derive the key of the encrypted credential files
+const DefaultKeyIterations = 100000
+
+// EncryptedFileCredentials is a provider that returns the password
+// from a file encrypted with AES-256-GCM, with the key derived from
+// a passphrase. The file is written by WriteEncryptedCredentials.
+type EncryptedFileCredentials struct {
+	// Path is the path of the encrypted file
+	Path string
+
+	// Passphrase returns the passphrase that
+	// the file was encrypted with
+	Passphrase func() (string, error)
+}
+
+// encryptedFile is the content of an encrypted credential file
+type encryptedFile struct {
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Password decrypts the file and returns the password
+func (e EncryptedFileCredentials) Password(account Account) (string, error) {
+	raw, err := ioutil.ReadFile(e.Path)
+	if os.IsNotExist(err) {
+		return "", ErrNoCredentials
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if e.Passphrase == nil {
+		return "", errors.New("go-oracle-cloud: Empty credential file passphrase")
+	}
+
+	passphrase, err := e.Passphrase()
+	if err != nil {
+		return "", err
+	}
+
+	var file encryptedFile
+	if err = json.Unmarshal(raw, &file); err != nil {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Invalid credential file %s: %v", e.Path, err,
+		)
+	}
+
+	if file.Iterations <= 0 {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Invalid credential file %s iterations", e.Path,
+		)
+	}
+
+	gcm, err := newCredentialsCipher(passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return "", err
+	}
+
+	if len(file.Nonce) != gcm.NonceSize() {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Invalid credential file %s nonce", e.Path,
+		)
+	}
+
+	password, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Cannot decrypt the credential file %s", e.Path,
+		)
+	}
+
+	return string(password), nil
+}
+
+// WriteEncryptedCredentials encrypts the password with a key derived
+// from the passphrase and writes it to the file at path, that can be
+// read with EncryptedFileCredentials
+func WriteEncryptedCredentials(path, passphrase, password string) error {
+	if passphrase == "" {
+		return errors.New("go-oracle-cloud: Empty credential file passphrase")
+	}
+
+	if password == "" {
+		return errors.New("go-oracle-cloud: Empty client password")
+	}
+
+	file := encryptedFile{
+		Iterations: DefaultKeyIterations,
+		Salt:       make([]byte, 16),
+	}
+
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+
+	gcm, err := newCredentialsCipher(passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(file.Nonce); err != nil {
+		return err
+	}
+
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, []byte(password), nil)
+
+	raw, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// newCredentialsCipher returns the AES-256-GCM cipher
+// with the key derived from the passphrase
+func newCredentialsCipher(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key := deriveCredentialsKey(passphrase, salt, iterations)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// deriveCredentialsKey derives the AES-256 key from
+// the passphrase with PBKDF2-HMAC-SHA256
+func deriveCredentialsKey(passphrase string, salt []byte, iterations int) []byte {
+	return pbkdf2.Key([]byte(passphrase), salt, iterations, 32, sha256.New)
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"encoding/hex"
+
+	gc "gopkg.in/check.v1"
+)
+
+type credentialsKeyTest struct{}
+
+var _ = gc.Suite(&credentialsKeyTest{})
+
+func (k credentialsKeyTest) TestDeriveKey(c *gc.C) {
+	// the keys of the encrypted files that are already written
+	// depend on the derivation, so it's pinned to the first
+	// 32 bytes of the PBKDF2-HMAC-SHA256 vectors of RFC 7914
+	for _, test := range []struct {
+		passphrase, salt string
+		iterations       int
+		key              string
+	}{{
+		passphrase: "passwd",
+		salt:       "salt",
+		iterations: 1,
+		key:        "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc",
+	}, {
+		passphrase: "Password",
+		salt:       "NaCl",
+		iterations: 80000,
+		key:        "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56",
+	}} {
+		key := deriveCredentialsKey(test.passphrase, []byte(test.salt), test.iterations)
+		c.Check(hex.EncodeToString(key), gc.Equals, test.key,
+			gc.Commentf("passphrase %q salt %q", test.passphrase, test.salt))
+	}
+}
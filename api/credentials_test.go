@@ -0,0 +1,144 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type credentialsTest struct{}
+
+var _ = gc.Suite(&credentialsTest{})
+
+var testAccount = api.Account{
+	Endpoint: "https://api-z52.compute.us2.oraclecloud.com",
+	Identify: "qbitq",
+	Username: "oracle@username.com",
+}
+
+// failingCredentials is a provider that always fails
+type failingCredentials struct{}
+
+func (f failingCredentials) Password(account api.Account) (string, error) {
+	return "", errors.New("keyring locked")
+}
+
+func (t credentialsTest) TestAuthenticate(c *gc.C) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+	defer server.Close()
+
+	cfg := server.Config()
+	cfg.Password = ""
+	cfg.Credentials = api.ChainCredentials{
+		api.StaticCredentials(""),
+		api.StaticCredentials("Password123"),
+	}
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	cfg.Credentials = failingCredentials{}
+	cli, err = api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.ErrorMatches, "keyring locked")
+
+	cfg.Credentials = nil
+	_, err = api.NewClient(cfg)
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Empty client password")
+}
+
+func (t credentialsTest) TestEnv(c *gc.C) {
+	password := os.Getenv(api.EnvPassword)
+	defer os.Setenv(api.EnvPassword, password)
+
+	os.Unsetenv(api.EnvPassword)
+	_, err := api.EnvCredentials{}.Password(testAccount)
+	c.Assert(err, gc.Equals, api.ErrNoCredentials)
+
+	os.Setenv(api.EnvPassword, "oraclepassword")
+	got, err := api.EnvCredentials{}.Password(testAccount)
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals, "oraclepassword")
+}
+
+func (t credentialsTest) TestCommand(c *gc.C) {
+	provider := api.CommandCredentials{
+		Name: "sh",
+		Args: []string{"-c", `
+			while read line && [ -n "$line" ]; do
+				case "$line" in
+				host=*) host=${line#host=} ;;
+				username=*) user=${line#username=} ;;
+				esac
+			done
+			echo "username=$user"
+			echo "password=$host:$user"
+		`},
+	}
+
+	got, err := provider.Password(testAccount)
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals,
+		"api-z52.compute.us2.oraclecloud.com:/Compute-qbitq/oracle@username.com")
+
+	provider.Args = []string{"-c", "echo quit=1"}
+	_, err = provider.Password(testAccount)
+	c.Assert(err, gc.Equals, api.ErrNoCredentials)
+
+	provider.Args = []string{"-c", "echo denied >&2; exit 1"}
+	_, err = provider.Password(testAccount)
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Credential command sh failed: exit status 1 denied")
+}
+
+func (t credentialsTest) TestEncryptedFile(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "credentials")
+	passphrase := func() (string, error) { return "secret", nil }
+
+	provider := api.EncryptedFileCredentials{Path: path, Passphrase: passphrase}
+	_, err := provider.Password(testAccount)
+	c.Assert(err, gc.Equals, api.ErrNoCredentials)
+
+	err = api.WriteEncryptedCredentials(path, "secret", "oraclepassword")
+	c.Assert(err, gc.IsNil)
+
+	got, err := provider.Password(testAccount)
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals, "oraclepassword")
+
+	provider.Passphrase = func() (string, error) { return "wrong", nil }
+	_, err = provider.Password(testAccount)
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Cannot decrypt the credential file .*")
+
+	// the chain stops at the providers that fail
+	_, err = api.ChainCredentials{
+		provider, api.StaticCredentials("oraclepassword"),
+	}.Password(testAccount)
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Cannot decrypt the credential file .*")
+}
+
+func (t credentialsTest) TestChain(c *gc.C) {
+	_, err := api.ChainCredentials{}.Password(testAccount)
+	c.Assert(err, gc.Equals, api.ErrNoCredentials)
+
+	got, err := api.ChainCredentials{
+		api.StaticCredentials(""),
+		api.EncryptedFileCredentials{Path: filepath.Join(c.MkDir(), "missing")},
+		api.StaticCredentials("oraclepassword"),
+		failingCredentials{},
+	}.Password(testAccount)
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals, "oraclepassword")
+}
@@ -188,6 +188,7 @@ func (c Client) DeleteImageList(name string) (err error) {
 // UpdateImageList updates the description of an image list.
 // You can also update the default image list entry to be used
 // while launching instances using the specified image list.
+// newName could be "" if you don't want to change the name.
 func (c Client) UpdateImageList(
 	currentName string,
 	newName string,
@@ -205,6 +206,10 @@ func (c Client) UpdateImageList(
 		)
 	}
 
+	if newName == "" {
+		newName = currentName
+	}
+
 	params := struct {
 		Def         int    `json:"default"`
 		Description string `json:"description,omitempty"`
@@ -213,15 +218,11 @@ func (c Client) UpdateImageList(
 		Def:         def,
 		Description: description,
 		Name: fmt.Sprintf("/Compute-%s/%s/%s",
-			c.identify, c.username, currentName),
-	}
-
-	if newName == "" {
-		newName = currentName
+			c.identify, c.username, newName),
 	}
 
 	url := fmt.Sprintf("%s/imagelist/Compute-%s/%s/%s",
-		c.endpoint, c.identify, c.username, newName)
+		c.endpoint, c.identify, c.username, currentName)
 
 	if err = request(paramsRequest{
 		client: &c.http,
@@ -230,6 +231,7 @@ func (c Client) UpdateImageList(
 		url:    url,
 		body:   &params,
 		treat:  defaultTreat,
+		resp:   &resp,
 	}); err != nil {
 		return resp, err
 	}
@@ -0,0 +1,41 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type imageListTest struct{}
+
+var _ = gc.Suite(&imageListTest{})
+
+func (i imageListTest) TestUpdateImageList(c *gc.C) {
+	server, cli, recorder := newRecordingClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateImageList(1, "ubuntu images", "ubuntu")
+	c.Assert(err, gc.IsNil)
+
+	// the current name is in the url and the new name in the body
+	list, err := cli.UpdateImageList("ubuntu", "xenial", "xenial images", 2)
+	c.Assert(err, gc.IsNil)
+	c.Assert(list.Name, gc.Equals, "xenial")
+	c.Assert(list.Description, gc.Equals, "xenial images")
+	c.Assert(list.Default, gc.Equals, 2)
+
+	body := recorder.body("PUT",
+		"/imagelist/Compute-myIdentify/oracleusername@oracle.com/ubuntu")
+	c.Assert(body["name"], gc.Equals,
+		"/Compute-myIdentify/oracleusername@oracle.com/xenial")
+
+	_, err = cli.ImageListDetails("ubuntu")
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 404 .*")
+
+	// without a new name the image list keeps its name
+	list, err = cli.UpdateImageList("xenial", "", "", 1)
+	c.Assert(err, gc.IsNil)
+	c.Assert(list.Name, gc.Equals, "xenial")
+	c.Assert(list.Default, gc.Equals, 1)
+}
@@ -0,0 +1,176 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// ImageListVersions retrieves all the entries of the
+// specified image list sorted ascending by their version.
+func (c Client) ImageListVersions(
+	name string,
+) (resp []response.ImageListEntry, err error) {
+
+	list, err := c.ImageListDetails(name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = list.Entries
+	sort.Sort(byVersion(resp))
+
+	return resp, nil
+}
+
+// byVersion orders the image list entries by their version
+type byVersion []response.ImageListEntry
+
+func (b byVersion) Len() int           { return len(b) }
+func (b byVersion) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byVersion) Less(i, j int) bool { return b[i].Version < b[j].Version }
+
+// LatestImageListEntry retrieves the entry with the
+// highest version of the specified image list.
+func (c Client) LatestImageListEntry(
+	name string,
+) (resp response.ImageListEntry, err error) {
+
+	entries, err := c.ImageListVersions(name)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(entries) == 0 {
+		return resp, fmt.Errorf(
+			"go-oracle-cloud: Image list %s has no entries", name,
+		)
+	}
+
+	return entries[len(entries)-1], nil
+}
+
+// DefaultImageListEntry retrieves the entry of the specified image
+// list that is used by default when launching instances.
+func (c Client) DefaultImageListEntry(
+	name string,
+) (resp response.ImageListEntry, err error) {
+
+	list, err := c.ImageListDetails(name)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, entry := range list.Entries {
+		if entry.Version == list.Default {
+			return entry, nil
+		}
+	}
+
+	return resp, fmt.Errorf(
+		"go-oracle-cloud: Image list %s has no default entry %d",
+		name, list.Default,
+	)
+}
+
+// PublishImageListEntry adds a new entry to the specified image list
+// with the version after the latest one, or 1 if the image list
+// has no entries. If setDefault is true the new entry becomes the
+// default entry of the image list.
+func (c Client) PublishImageListEntry(
+	name string,
+	attributes map[string]interface{},
+	machineImages []string,
+	setDefault bool,
+) (resp response.ImageListEntryAdd, err error) {
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty image list name")
+	}
+
+	list, err := c.ImageListDetails(name)
+	if err != nil {
+		return resp, err
+	}
+
+	version := 1
+	for _, entry := range list.Entries {
+		if entry.Version >= version {
+			version = entry.Version + 1
+		}
+	}
+
+	if attributes == nil {
+		attributes = make(map[string]interface{})
+	}
+
+	if resp, err = c.AddImageListEntry(
+		name, attributes, version, machineImages,
+	); err != nil {
+		return resp, err
+	}
+
+	if setDefault {
+		if _, err = c.UpdateImageList(
+			name, "", list.Description, version,
+		); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// PruneImageListEntries deletes the oldest entries of the specified
+// image list keeping the newest keep entries. The default entry
+// of the image list is never deleted. It returns the versions
+// of the entries that were deleted.
+func (c Client) PruneImageListEntries(
+	name string,
+	keep int,
+) (deleted []int, err error) {
+
+	if keep < 1 {
+		return nil, fmt.Errorf(
+			"go-oracle-cloud: Invalid number of image list entries to keep %d",
+			keep,
+		)
+	}
+
+	list, err := c.ImageListDetails(name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int, 0, len(list.Entries))
+	for _, entry := range list.Entries {
+		versions = append(versions, entry.Version)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	if len(versions) <= keep {
+		return nil, nil
+	}
+
+	for _, version := range versions[keep:] {
+		if version == list.Default {
+			continue
+		}
+
+		if err = c.DeleteImageListEntry(
+			name, strconv.Itoa(version),
+		); err != nil {
+			return deleted, err
+		}
+
+		deleted = append(deleted, version)
+	}
+
+	return deleted, nil
+}
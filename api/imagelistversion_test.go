@@ -0,0 +1,51 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type imageListVersionTest struct{}
+
+var _ = gc.Suite(&imageListVersionTest{})
+
+func (i imageListVersionTest) TestPublishAndPrune(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateImageList(1, "ubuntu images", "ubuntu")
+	c.Assert(err, gc.IsNil)
+
+	for n := 0; n < 4; n++ {
+		_, err = cli.PublishImageListEntry("ubuntu", nil,
+			[]string{"ubuntu-xenial"}, n == 1)
+		c.Assert(err, gc.IsNil)
+	}
+
+	entries, err := cli.ImageListVersions("ubuntu")
+	c.Assert(err, gc.IsNil)
+	c.Assert(entries, gc.HasLen, 4)
+	for n, entry := range entries {
+		c.Assert(entry.Version, gc.Equals, n+1)
+	}
+
+	latest, err := cli.LatestImageListEntry("ubuntu")
+	c.Assert(err, gc.IsNil)
+	c.Assert(latest.Version, gc.Equals, 4)
+
+	def, err := cli.DefaultImageListEntry("ubuntu")
+	c.Assert(err, gc.IsNil)
+	c.Assert(def.Version, gc.Equals, 2)
+
+	deleted, err := cli.PruneImageListEntries("ubuntu", 1)
+	c.Assert(err, gc.IsNil)
+	c.Assert(deleted, gc.DeepEquals, []int{3, 1})
+
+	entries, err = cli.ImageListVersions("ubuntu")
+	c.Assert(err, gc.IsNil)
+	c.Assert(entries, gc.HasLen, 2)
+	c.Assert(entries[0].Version, gc.Equals, 2)
+	c.Assert(entries[1].Version, gc.Equals, 4)
+}
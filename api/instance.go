@@ -4,6 +4,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -63,12 +64,7 @@ func (c Client) AllInstances() (resp response.AllInstance, err error) {
 	}
 
 	for key := range resp.Result {
-		strip(&resp.Result[key].Imagelist)
-		for alt := range resp.Result[key].SSHKeys {
-			strip(&resp.Result[key].SSHKeys[alt])
-		}
-		list := strings.Split(resp.Result[key].Name, "/")
-		resp.Result[key].Name = list[len(list)-2] + "/" + list[len(list)-1]
+		stripInstance(&resp.Result[key])
 	}
 	return resp, nil
 }
@@ -76,6 +72,15 @@ func (c Client) AllInstances() (resp response.AllInstance, err error) {
 // InstanceDetails retrieves details of the specified instance.
 // Name is the form of dev-name/uuid
 func (c Client) InstanceDetails(name string) (resp response.Instance, err error) {
+	return c.instanceDetails(nil, name)
+}
+
+// instanceDetails retrieves the details of the instance within the ctx
+func (c Client) instanceDetails(
+	ctx context.Context,
+	name string,
+) (resp response.Instance, err error) {
+
 	if !c.isAuth() {
 		return resp, ErrNotAuth
 	}
@@ -84,6 +89,7 @@ func (c Client) InstanceDetails(name string) (resp response.Instance, err error)
 		c.endpoint, c.identify, c.username, name)
 
 	if err = request(paramsRequest{
+		ctx:    ctx,
 		client: &c.http,
 		cookie: c.cookie,
 		url:    url,
@@ -94,12 +100,7 @@ func (c Client) InstanceDetails(name string) (resp response.Instance, err error)
 		return resp, err
 	}
 
-	strip(&resp.Imagelist)
-	for alt := range resp.SSHKeys {
-		strip(&resp.SSHKeys[alt])
-	}
-	list := strings.Split(resp.Name, "/")
-	resp.Name = list[len(list)-2] + "/" + list[len(list)-1]
+	stripInstance(&resp)
 
 	return resp, nil
 }
@@ -128,3 +129,30 @@ func (c Client) AllInstanceNames() (resp response.DirectoryNames, err error) {
 
 	return resp, nil
 }
+
+// stripInstance strips all the names that the instance references.
+// The name of the instance is kept in the form of name/id.
+func stripInstance(instance *response.Instance) {
+	strip(&instance.Imagelist)
+	for key := range instance.SSHKeys {
+		strip(&instance.SSHKeys[key])
+	}
+	list := strings.Split(instance.Name, "/")
+	instance.Name = list[len(list)-2] + "/" + list[len(list)-1]
+	stripNetworking(&instance.Networking)
+}
+
+// stripNetworking strips all the names that
+// the networking interfaces reference
+func stripNetworking(networking *response.Networking) {
+	for _, nic := range networking.Interfaces() {
+		for key := range nic.Seclists {
+			strip(&nic.Seclists[key])
+		}
+		strip(&nic.Ipnetwork)
+		strip(&nic.Vnic)
+		for key := range nic.Vnicsets {
+			strip(&nic.Vnicsets[key])
+		}
+	}
+}
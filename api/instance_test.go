@@ -0,0 +1,114 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type instanceTest struct{}
+
+var _ = gc.Suite(&instanceTest{})
+
+func (i instanceTest) TestLaunchPlan(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	_, err := cli.AddSHHKey("juju", "ssh-rsa AAAA", true)
+	c.Assert(err, gc.IsNil)
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		SSHKeys("juju").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	plan, err := cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(plan.Instances, gc.HasLen, 1)
+	c.Assert(plan.Instances[0].State, gc.Equals, response.InstanceRunning)
+
+	all, err := cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+	c.Assert(all.Result, gc.HasLen, 1)
+
+	name := all.Result[0].Name
+	c.Assert(strings.HasPrefix(name, "web/"), gc.Equals, true)
+	c.Assert(all.Result[0].SSHKeys, gc.DeepEquals, []string{"juju"})
+
+	details, err := cli.InstanceDetails(name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(details.Shape, gc.Equals, "oc3")
+	c.Assert(details.Imagelist, gc.Equals, "OL_7.2_UEKR4_x86_64")
+
+	names, err := cli.AllInstanceNames()
+	c.Assert(err, gc.IsNil)
+	c.Assert(names.Result, gc.DeepEquals, []string{
+		"/Compute-myIdentify/oracleusername@oracle.com/web/",
+	})
+
+	c.Assert(cli.DeleteInstance(name), gc.IsNil)
+
+	_, err = cli.InstanceDetails(name)
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 404 .*")
+}
+
+func (i instanceTest) TestLaunchPlanNetworking(c *gc.C) {
+	server, cli, recorder := newRecordingClient(c)
+	defer server.Close()
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		SSHKeys("juju").
+		Interface(0, response.Nic{
+			Vethernet: "/oracle/public/default",
+			Seclists:  []string{"web"},
+			Nat:       response.Nat{"ipreservation:public"},
+		}).
+		Interface(1, response.Nic{
+			Ipnetwork: "net1",
+			Vnicsets:  []string{"set1"},
+			Nat:       response.Nat{"network/v1/ipreservation:reserved", "ippool:/oracle/public/ippool"},
+		}).
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	params := api.InstanceParams{Instances: []api.Instances{instance}}
+	_, err = cli.CreateInstance(params)
+	c.Assert(err, gc.IsNil)
+
+	prefix := "/Compute-myIdentify/oracleusername@oracle.com/"
+	body := recorder.body("POST", "/launchplan/")
+	networking := body["instances"].([]interface{})[0].(map[string]interface{})["networking"]
+	c.Assert(networking, gc.DeepEquals, map[string]interface{}{
+		"eth0": map[string]interface{}{
+			"vethernet": "/oracle/public/default",
+			"seclists":  []interface{}{prefix + "web"},
+			"nat":       "ipreservation:" + prefix + "public",
+		},
+		"eth1": map[string]interface{}{
+			"ipnetwork": prefix + "net1",
+			"vnicsets":  []interface{}{prefix + "set1"},
+			"nat": []interface{}{
+				"network/v1/ipreservation:" + prefix + "reserved",
+				"ippool:/oracle/public/ippool",
+			},
+		},
+	})
+
+	// the params of the caller are left as they are
+	c.Assert(params.Instances[0], gc.DeepEquals, instance)
+	c.Assert(instance.Name, gc.Equals, "web")
+	c.Assert(instance.SSHKeys, gc.DeepEquals, []string{"juju"})
+	c.Assert(instance.Networking.Eth0.Seclists, gc.DeepEquals, []string{"web"})
+	c.Assert(instance.Networking.Eth0.Nat, gc.DeepEquals, response.Nat{"ipreservation:public"})
+	c.Assert(instance.Networking.Eth1.Ipnetwork, gc.Equals, "net1")
+	c.Assert(instance.Networking.Eth1.Vnicsets, gc.DeepEquals, []string{"set1"})
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// InstanceBuilder builds up the params of an instance from
+// a launch plan. Every method returns the builder so the
+// calls can be chained and Build validates the result.
+type InstanceBuilder struct {
+	instance Instances
+	err      error
+}
+
+// NewInstanceBuilder returns a new instance builder
+// for an instance with the given name, label and shape
+func NewInstanceBuilder(name, label, shape string) *InstanceBuilder {
+	return &InstanceBuilder{
+		instance: Instances{
+			Name:  name,
+			Label: label,
+			Shape: shape,
+		},
+	}
+}
+
+// Imagelist sets the imagelist that the instance
+// will be launched from
+func (b *InstanceBuilder) Imagelist(name string) *InstanceBuilder {
+	b.instance.Imagelist = name
+	return b
+}
+
+// Entry pins the imagelist entry that the instance will be
+// launched from, instead of the default entry of the imagelist
+func (b *InstanceBuilder) Entry(entry int) *InstanceBuilder {
+	b.instance.Entry = entry
+	return b
+}
+
+// SSHKeys adds ssh keys that will be installed on the instance
+func (b *InstanceBuilder) SSHKeys(keys ...string) *InstanceBuilder {
+	b.instance.SSHKeys = append(b.instance.SSHKeys, keys...)
+	return b
+}
+
+// Hostname sets the hostname of the instance
+func (b *InstanceBuilder) Hostname(hostname string) *InstanceBuilder {
+	b.instance.Hostname = hostname
+	return b
+}
+
+// Tags adds tags to the instance
+func (b *InstanceBuilder) Tags(tags ...string) *InstanceBuilder {
+	b.instance.Tags = append(b.instance.Tags, tags...)
+	return b
+}
+
+// Attributes adds attributes to the instance
+func (b *InstanceBuilder) Attributes(attributes map[string]interface{}) *InstanceBuilder {
+	if b.instance.Attributes == nil {
+		b.instance.Attributes = make(map[string]interface{})
+	}
+	for key, value := range attributes {
+		b.instance.Attributes[key] = value
+	}
+	return b
+}
+
+// Userdata adds the userdata attributes to the instance
+func (b *InstanceBuilder) Userdata(userdata *Userdata) *InstanceBuilder {
+	attributes, err := userdata.Attributes()
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	return b.Attributes(attributes)
+}
+
+// ReverseDns sets if the reverse dns records
+// of the instance should be created
+func (b *InstanceBuilder) ReverseDns(enabled bool) *InstanceBuilder {
+	b.instance.Reverse_dns = &enabled
+	return b
+}
+
+// StorageAttachment attaches the storage volume to the
+// instance using the index given, from 1 to 10
+func (b *InstanceBuilder) StorageAttachment(index int, volume string) *InstanceBuilder {
+	b.instance.Storage_attachments = append(
+		b.instance.Storage_attachments,
+		StorageAttachment{Index: index, Volume: volume},
+	)
+	return b
+}
+
+// BootOrder sets the storage attachment indexes that
+// the instance boots from
+func (b *InstanceBuilder) BootOrder(indexes ...int) *InstanceBuilder {
+	b.instance.Boot_order = indexes
+	return b
+}
+
+// PlacementRequirements adds placement requirements to the instance
+func (b *InstanceBuilder) PlacementRequirements(requirements ...string) *InstanceBuilder {
+	b.instance.Placement_requirements = append(
+		b.instance.Placement_requirements, requirements...,
+	)
+	return b
+}
+
+// Priority sets the priority of the instance
+func (b *InstanceBuilder) Priority(priority string) *InstanceBuilder {
+	b.instance.Priority = priority
+	return b
+}
+
+// DesiredState sets the state of the instance after
+// it's launched, running or shutdown
+func (b *InstanceBuilder) DesiredState(state string) *InstanceBuilder {
+	b.instance.Desired_state = state
+	return b
+}
+
+// Interface sets the network interface eth<index> of the
+// instance, the index should be from 0 to 7
+func (b *InstanceBuilder) Interface(index int, nic response.Nic) *InstanceBuilder {
+	if b.instance.Networking == nil {
+		b.instance.Networking = &response.Networking{}
+	}
+
+	n := b.instance.Networking
+	switch index {
+	case 0:
+		n.Eth0 = &nic
+	case 1:
+		n.Eth1 = &nic
+	case 2:
+		n.Eth2 = &nic
+	case 3:
+		n.Eth3 = &nic
+	case 4:
+		n.Eth4 = &nic
+	case 5:
+		n.Eth5 = &nic
+	case 6:
+		n.Eth6 = &nic
+	case 7:
+		n.Eth7 = &nic
+	default:
+		if b.err == nil {
+			b.err = fmt.Errorf(
+				"go-oracle-cloud: Invalid network interface eth%d, it should be from eth0 to eth7",
+				index,
+			)
+		}
+	}
+
+	return b
+}
+
+// Build validates the combination of the params
+// and returns the instance params
+func (b *InstanceBuilder) Build() (Instances, error) {
+	if b.err != nil {
+		return Instances{}, b.err
+	}
+
+	if err := b.instance.validate(); err != nil {
+		return Instances{}, err
+	}
+
+	return b.instance, nil
+}
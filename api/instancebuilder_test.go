@@ -0,0 +1,144 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type instanceBuilderTest struct{}
+
+var _ = gc.Suite(&instanceBuilderTest{})
+
+const testImagelist = "/oracle/public/OL_7.2_UEKR4_x86_64"
+
+func (i instanceBuilderTest) TestBuild(c *gc.C) {
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist(testImagelist).
+		Entry(2).
+		SSHKeys("juju").
+		Tags("web", "prod").
+		ReverseDns(false).
+		StorageAttachment(1, "data").
+		DesiredState("shutdown").
+		Interface(0, response.Nic{Vethernet: "/oracle/public/default"}).
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	reverse := false
+	c.Assert(instance, gc.DeepEquals, api.Instances{
+		Name:      "web",
+		Label:     "web",
+		Shape:     "oc3",
+		Imagelist: testImagelist,
+		Entry:     2,
+		SSHKeys:   []string{"juju"},
+		Tags:      []string{"web", "prod"},
+		Networking: &response.Networking{
+			Eth0: &response.Nic{Vethernet: "/oracle/public/default"},
+		},
+		Reverse_dns: &reverse,
+		Storage_attachments: []api.StorageAttachment{
+			{Index: 1, Volume: "data"},
+		},
+		Desired_state: "shutdown",
+	})
+}
+
+func (i instanceBuilderTest) TestUserdataMerge(c *gc.C) {
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist(testImagelist).
+		Attributes(map[string]interface{}{"role": "web"}).
+		Userdata(api.NewUserdata().Set("port", 80)).
+		Attributes(map[string]interface{}{"zone": "a"}).
+		Build()
+	c.Assert(err, gc.IsNil)
+	c.Assert(instance.Attributes, gc.DeepEquals, map[string]interface{}{
+		"role":     "web",
+		"zone":     "a",
+		"userdata": map[string]interface{}{"port": 80},
+	})
+
+	_, err = api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist(testImagelist).
+		Userdata(api.NewUserdata().CloudConfig("packages: []")).
+		Build()
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: The cloud-config document should start with #cloud-config")
+}
+
+func (i instanceBuilderTest) TestValidate(c *gc.C) {
+	for _, test := range []struct {
+		about   string
+		builder *api.InstanceBuilder
+		err     string
+	}{{
+		about:   "missing shape",
+		builder: api.NewInstanceBuilder("web", "web", "").Imagelist(testImagelist),
+		err:     "go-oracle-cloud: Empty shape in instance parameters",
+	}, {
+		about:   "missing label",
+		builder: api.NewInstanceBuilder("web", "", "oc3").Imagelist(testImagelist),
+		err:     "go-oracle-cloud: Empty label in instance parameters",
+	}, {
+		about:   "missing image list",
+		builder: api.NewInstanceBuilder("web", "web", "oc3"),
+		err:     "go-oracle-cloud: Empty image list in instance parameters",
+	}, {
+		about: "storage attachment index too small",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			StorageAttachment(0, "data"),
+		err: "go-oracle-cloud: Invalid storage attachment index 0, it should be from 1 to 10",
+	}, {
+		about: "storage attachment index too big",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			StorageAttachment(11, "data"),
+		err: "go-oracle-cloud: Invalid storage attachment index 11, it should be from 1 to 10",
+	}, {
+		about: "duplicate storage attachment index",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			StorageAttachment(1, "data").
+			StorageAttachment(1, "logs"),
+		err: "go-oracle-cloud: Duplicate storage attachment index 1",
+	}, {
+		about: "boot order without attachment",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			StorageAttachment(1, "boot").
+			BootOrder(2),
+		err: "go-oracle-cloud: Boot order 2 requires a bootable storage attachment with the same index",
+	}, {
+		about: "shared and ip network interface",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			Interface(1, response.Nic{Vethernet: "/oracle/public/default", Ipnetwork: "net"}),
+		err: "go-oracle-cloud: Interface eth1 can't be attached both to the shared network and to an ip network",
+	}, {
+		about: "invalid interface",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			Interface(8, response.Nic{}),
+		err: "go-oracle-cloud: Invalid network interface eth8, it should be from eth0 to eth7",
+	}, {
+		about: "invalid desired state",
+		builder: api.NewInstanceBuilder("web", "web", "oc3").
+			Imagelist(testImagelist).
+			DesiredState("stopped"),
+		err: `go-oracle-cloud: Invalid desired state "stopped", it should be running or shutdown`,
+	}} {
+		c.Logf("test %q", test.about)
+		_, err := test.builder.Build()
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+
+	_, err := api.NewInstanceBuilder("web", "web", "oc3").
+		StorageAttachment(1, "boot").
+		BootOrder(1).
+		Build()
+	c.Assert(err, gc.IsNil)
+}
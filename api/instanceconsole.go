@@ -0,0 +1,150 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// InstanceConsoleDetails retrieves the serial console output of the
+// specified instance. Name is the form of dev-name/uuid
+func (c Client) InstanceConsoleDetails(
+	name string,
+) (resp response.InstanceConsole, err error) {
+	return c.instanceConsoleDetails(nil, name)
+}
+
+// instanceConsoleDetails retrieves the serial console
+// output of the specified instance within the ctx
+func (c Client) instanceConsoleDetails(
+	ctx context.Context,
+	name string,
+) (resp response.InstanceConsole, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty instance name")
+	}
+
+	url := fmt.Sprintf("%s/instanceconsole/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		ctx:    ctx,
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	list := strings.Split(resp.Name, "/")
+	if len(list) > 1 {
+		resp.Name = list[len(list)-2] + "/" + list[len(list)-1]
+	}
+
+	return resp, nil
+}
+
+// TailInstanceConsole polls the serial console output of the specified
+// instance at every interval and writes only the new lines to w.
+// It stops when the ctx is done or when an error occurs.
+func (c Client) TailInstanceConsole(
+	ctx context.Context,
+	name string,
+	w io.Writer,
+	interval time.Duration,
+) error {
+
+	if interval <= 0 {
+		return errors.New("go-oracle-cloud: Invalid console polling interval")
+	}
+
+	var tail consoleTail
+	for {
+		console, err := c.instanceConsoleDetails(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		if lines := tail.next(console.Output); lines != "" {
+			if _, err = io.WriteString(w, lines); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// consoleTail keeps track of the console output
+// that was already written
+type consoleTail struct {
+	// seen is the output that was already written,
+	// it always ends with a new line
+	seen string
+}
+
+// next returns the complete lines from the output
+// that were not written yet
+func (t *consoleTail) next(output string) string {
+	fresh := output[overlap(t.seen, output):]
+
+	n := strings.LastIndex(fresh, "\n")
+	if n < 0 {
+		return ""
+	}
+
+	t.seen = output[:len(output)-len(fresh)+n+1]
+	return fresh[:n+1]
+}
+
+// overlap returns the length of the longest complete lines at the start
+// of the output that are also at the end of the seen output. When the
+// console buffer rotates, the output continues after them.
+func overlap(seen, output string) int {
+	if strings.HasPrefix(output, seen) {
+		return len(seen)
+	}
+
+	k := len(output)
+	if k > len(seen) {
+		k = len(seen)
+	}
+
+	for ; k > 0; k-- {
+		// the overlap should be made only of complete lines
+		if output[k-1] != '\n' {
+			continue
+		}
+
+		start := len(seen) - k
+		if start > 0 && seen[start-1] != '\n' {
+			continue
+		}
+
+		if seen[start:] == output[:k] {
+			return k
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type consoleTailTest struct{}
+
+var _ = gc.Suite(&consoleTailTest{})
+
+func (t consoleTailTest) TestNext(c *gc.C) {
+	for _, test := range []struct {
+		about   string
+		outputs []string
+		want    []string
+	}{{
+		about:   "first read",
+		outputs: []string{"a\nb\n"},
+		want:    []string{"a\nb\n"},
+	}, {
+		about:   "incomplete line",
+		outputs: []string{"a\nb", "a\nb\nc"},
+		want:    []string{"a\n", "b\n"},
+	}, {
+		about:   "append",
+		outputs: []string{"a\nb\n", "a\nb\nc\n", "a\nb\nc\nd\ne\n"},
+		want:    []string{"a\nb\n", "c\n", "d\ne\n"},
+	}, {
+		about:   "identical output",
+		outputs: []string{"a\nb\n", "a\nb\n", "a\nb\n"},
+		want:    []string{"a\nb\n", "", ""},
+	}, {
+		about:   "rotation",
+		outputs: []string{"a\nb\nc\n", "b\nc\nd\n", "c\nd\ne\nf\n"},
+		want:    []string{"a\nb\nc\n", "d\n", "e\nf\n"},
+	}, {
+		about:   "rotation with blank trailing lines",
+		outputs: []string{"a\nb\n\n", "b\n\nc\nd\n", "\nc\nd\ne\n", "c\nd\ne\n"},
+		want:    []string{"a\nb\n\n", "c\nd\n", "e\n", ""},
+	}, {
+		about:   "rotation with repeated lines",
+		outputs: []string{"x\ny\nx\n", "y\nx\nx\n"},
+		want:    []string{"x\ny\nx\n", "x\n"},
+	}, {
+		about:   "rotation without overlap",
+		outputs: []string{"a\nb\n", "c\nd\n"},
+		want:    []string{"a\nb\n", "c\nd\n"},
+	}} {
+		c.Logf("test %q", test.about)
+
+		var tail consoleTail
+		for i, output := range test.outputs {
+			c.Check(tail.next(output), gc.Equals, test.want[i])
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+//go:generate go run ../internal/mockgen/main.go -source interfaces.go -output ../apimock/mocks.go -package apimock -import github.com/hoenirvili/go-oracle-cloud/api
+
+// AuthAPI groups the session and the account operations.
+// Code that holds a long lived client through the other interfaces
+// could use it to authenticate again or to refresh the session cookie.
+// *Client satisfies this interface.
+type AuthAPI interface {
+	Authenticate() error
+	RefreshCookie() error
+
+	AccountDetails(name string) (response.Account, error)
+	AllAccountDetais() (response.AllAccount, error)
+	AllAccountNames() (response.DirectoryNames, error)
+	DirectoryAccount() (response.DirectoryNames, error)
+}
+
+// InstanceAPI groups the operations on instances, launch plans,
+// reboot requests, instance consoles and shapes.
+// *Client satisfies this interface.
+type InstanceAPI interface {
+	CreateInstance(params InstanceParams) (response.LaunchPlan, error)
+	DeleteInstance(name string) error
+	InstanceDetails(name string) (response.Instance, error)
+	AllInstances() (response.AllInstance, error)
+	AllInstanceNames() (response.DirectoryNames, error)
+	ListInstances(ctx context.Context) *InstanceIterator
+	BulkInstanceDetails(ctx context.Context, names []string, workers int) []InstanceResult
+
+	CreateRebootInstanceRequest(hard bool, instanceName string) (response.RebootInstanceRequest, error)
+	DeleteRebootInstanceRequest(instanceName string) error
+	RebootInstanceRequestDetails(instanceName string) (response.RebootInstanceRequest, error)
+	AllRebootInstanceRequest() (response.AllRebootInstanceRequest, error)
+
+	InstanceConsoleDetails(name string) (response.InstanceConsole, error)
+	TailInstanceConsole(ctx context.Context, name string, w io.Writer, interval time.Duration) error
+
+	ShapeDetails(name string) (response.Shape, error)
+	AllShapeDetails() (response.AllShape, error)
+	ShapeCatalogue() (ShapeCatalogue, error)
+}
+
+// NetworkAPI groups the operations on the shared network ip
+// reservations and associations and on the ip networks resources,
+// like ip networks, exchanges, reservations, associations, vnics,
+// vnic sets and routes.
+// *Client satisfies this interface.
+type NetworkAPI interface {
+	CreateIpReservation(currentName string, newName string, parentpool string, permanent bool, tags []string) (response.IpReservation, error)
+	DeleteIpReservation(name string) error
+	IpReservationDetails(name string) (response.IpReservation, error)
+	AllIpReservation() (response.AllIpReservation, error)
+	UpdateIpReservation(currentName string, newName string, parentpool string, permanent bool, tags []string) (response.IpReservation, error)
+
+	CreateIpAssociation(parentpool string, vcable string) (response.IpAssociation, error)
+	DeleteIpAssociation(name string) error
+	IpAssociationDetails(name string) (response.IpAssociation, error)
+	AllIpAssociation() (response.AllIpAssociation, error)
+
+	CreateIp(description string, ipAddressPrefix string, ipNetworkExchange string, name string, publicNaptEnabledFlag bool, tags []string) (response.Ip, error)
+	DeleteIp(name string) error
+	IpDetails(name string) (response.Ip, error)
+	AllIp() (response.AllIp, error)
+	UpdateIp(currentName string, newName string, description string, ipNetworkExchange string, ipAddressPrefix string, publicNaptEnabledFlag bool, tags []string) (response.Ip, error)
+
+	CreateIpNetworkExchange(name string, description string, tags []string) (response.IpNetworkExchange, error)
+	DeleteIpNetworkExchange(name string) error
+	IpNetworkExchangeDetails(name string) (response.IpNetworkExchange, error)
+	AllIpNetworkExchange() (response.AllIpNetworkExchange, error)
+
+	CreateIpAddressReservation(name string, description string, ipAddressPool response.IpAddressPool, tags []string) (response.IpAddressReservation, error)
+	DeleteIpAddressReservation(name string) error
+	IpAddressReservationDetails(name string) (response.IpAddressReservation, error)
+	AllIpAddressReservation() (response.AllIpAddressReservation, error)
+	UpdateIpAddressReservation(currentName string, newName string, description string, ipAddressPool response.IpAddressPool, tags []string) (response.IpAddressReservation, error)
+
+	CreateIpAddressAssociation(description string, ipAddressReservation string, vnic string, name string, tags []string) (response.IpAddressAssociation, error)
+	DeleteIpAddressAssociation(name string) error
+	IpAddressAssociationDetails(name string) (response.IpAddressAssociation, error)
+	AllIpAddressAssociation() (response.AllIpAddressAssociation, error)
+	UpdateIpAddressAssociation(currentName, ipAddressReservation, vnic, newName string) (response.IpAddressAssociation, error)
+
+	CreateIpAddressPrefixSet(name string, description string, ipAddressPrefixes []string, tags []string) (response.IpAddressPrefixSet, error)
+	DeleteIpAddressPrefixSet(name string) error
+	IpAddressPrefixSetDetails(name string) (response.IpAddressPrefixSet, error)
+	AllIpAddressPrefixSet() (response.AllIpAddressPrefixSet, error)
+	UpdateIpAddressPrefixSet(currentName string, newName string, description string, ipAddressPrefixes []string, tags []string) (response.IpAddressPrefixSet, error)
+
+	VirtualNic(name string) (response.VirtualNic, error)
+	AllVirtualNic() (response.AllVirtualNic, error)
+	BulkVirtualNic(ctx context.Context, names []string, workers int) []VirtualNicResult
+
+	CreateVnicSet(name string, description string, vnics []string, appliedAcls []string, tags []string) (response.VnicSet, error)
+	DeleteVnicSet(name string) error
+	VnicSetDetails(name string) (response.VnicSet, error)
+	AllVnicSet() (response.AllVnicSet, error)
+	UpdateVnicSet(currentName string, newName string, description string, vnics []string, appliedAcls []string, tags []string) (response.VnicSet, error)
+	AddVnicToSet(name string, vnic string) (response.VnicSet, error)
+	RemoveVnicFromSet(name string, vnic string) (response.VnicSet, error)
+
+	CreateRoute(name string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (response.Route, error)
+	DeleteRoute(name string) error
+	RouteDetails(name string) (response.Route, error)
+	AllRoute() (response.AllRoute, error)
+	UpdateRoute(currentName string, newName string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (response.Route, error)
+}
+
+// StorageAPI groups the operations on storage volumes,
+// storage properties and backup configurations.
+// *Client satisfies this interface.
+type StorageAPI interface {
+	CreateStorageVolume(p StorageVolumeParams) (response.StorageVolume, error)
+	DeleteStorageVolume(name string) error
+	StorageVolumeDetails(name string) (response.StorageVolume, error)
+	AllStorageVolume() (response.AllStorageVolume, error)
+	ListStorageVolumes(ctx context.Context) *StorageVolumeIterator
+	BulkStorageVolumeDetails(ctx context.Context, names []string, workers int) []StorageVolumeResult
+
+	StoragePropertyDetails(name string) (response.StorageProperty, error)
+	AllStorageProperty() (response.AllStorageProperty, error)
+
+	CreateBackupConfiguration(p BackupConfigurationParams) (response.BackupConfiguration, error)
+	DeleteBackupConfiguration(name string) error
+	BackupConfigurationDetails(name string) (response.BackupConfiguration, error)
+	AllBackupConfiguration() ([]response.BackupConfiguration, error)
+	UpdateBackupConfiguration(p BackupConfigurationParams, newName string) (response.BackupConfiguration, error)
+}
+
+// SecurityAPI groups the operations on ssh keys, security lists,
+// security ip lists, security applications, security associations
+// and on the ip networks acls, security protocols and security rules.
+// *Client satisfies this interface.
+type SecurityAPI interface {
+	AddSHHKey(name string, key string, enabled bool) (response.SSH, error)
+	DeleteSSHKey(name string) error
+	SSHKeyDetails(name string) (response.SSH, error)
+	AllSSHKeyDetails() (response.AllSSH, error)
+	AllSSHKeyNames() (response.AllSSHNames, error)
+	UpdateSSHKey(name string, key string, enabled bool) (response.SSH, error)
+
+	CreateSecList(description string, name string, outbound_cidr_policy string, policy string) (response.SecList, error)
+	DeleteSecList(name string) error
+	SecListDetails(name string) (response.SecList, error)
+	AllSecList() (response.AllSecList, error)
+	UpdateSecList(description string, currentName string, newName string, outbound_cidr_policy string, policy string) (response.SecList, error)
+
+	CreateSecIpList(description string, name string, secipentries []string) (response.SecIpList, error)
+	DeleteSecIpList(name string) error
+	IpSecListDetail(name string) (response.SecIpList, error)
+	AllSecIpList() (response.AllSecIpList, error)
+	UpdateSecIpList(description string, currentName string, newName string, secipentries []string) (response.SecIpList, error)
+
+	CreateSecApplication(p SecApplicationParams) (response.SecApplication, error)
+	DeleteSecApplication(name string) error
+	SecApplicationDetails(name string) (response.SecApplication, error)
+	AllSecApplication() (response.AllSecApplication, error)
+	DefaultSecApplicationDetails(name string) (response.SecApplication, error)
+	AllDefaultSecApplication() (response.AllSecApplication, error)
+
+	CreateSecAssociation(name string, seclist string, vcable string) (response.SecAssociation, error)
+	DeleteSecAssociation(name string) error
+	SecAssociationDetails(name string) (response.SecAssociation, error)
+	AllSecAssociation() (response.AllSecAssociation, error)
+	InstanceVcable(instanceName string) (string, error)
+
+	CreateAcl(name string, description string, enabledFlag bool, tags []string) (response.Acl, error)
+	DeleteAcl(name string) error
+	AclDetails(name string) (response.Acl, error)
+	AllAcl() (response.AllAcl, error)
+	UpdateAcl(currentName string, newName string, description string, enableFlag bool, tags []string) (response.Acl, error)
+
+	CreateSecurityProtocol(name string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (response.SecurityProtocol, error)
+	DeleteSecurityProtocol(name string) error
+	SecurityProtocolDetails(name string) (response.SecurityProtocol, error)
+	AllSecurityProtocol() (response.AllSecurityProtocol, error)
+	UpdateSecurityProtocol(currentName string, newName string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (response.SecurityProtocol, error)
+
+	CreateSecurityRule(p SecurityRuleParams) (response.SecurityRule, error)
+	DeleteSecurityRule(name string) error
+	SecurityRuleDetails(name string) (response.SecurityRule, error)
+	AllSecurityRule() (response.AllSecurityRule, error)
+	UpdateSecurityRule(p SecurityRuleParams, newName string) (response.SecurityRule, error)
+}
+
+// ImageAPI groups the operations on image lists and image list entries.
+// *Client satisfies this interface.
+type ImageAPI interface {
+	CreateImageList(def int, description string, name string) (response.ImageList, error)
+	DeleteImageList(name string) error
+	ImageListDetails(name string) (response.ImageList, error)
+	AllImageList() (response.AllImageList, error)
+	AllImageListNames() (response.DirectoryNames, error)
+	UpdateImageList(currentName string, newName string, description string, def int) (response.ImageList, error)
+
+	ImageListEntry(name string, version string) (response.ImageListEntry, error)
+	AddImageListEntry(name string, attributes map[string]interface{}, version int, machineImages []string) (response.ImageListEntryAdd, error)
+	DeleteImageListEntry(name string, version string) error
+
+	ImageListVersions(name string) ([]response.ImageListEntry, error)
+	LatestImageListEntry(name string) (response.ImageListEntry, error)
+	DefaultImageListEntry(name string) (response.ImageListEntry, error)
+	PublishImageListEntry(name string, attributes map[string]interface{}, machineImages []string, setDefault bool) (response.ImageListEntryAdd, error)
+	PruneImageListEntries(name string, keep int) ([]int, error)
+}
+
+// the client must satisfy all the interfaces
+var (
+	_ AuthAPI     = (*Client)(nil)
+	_ InstanceAPI = (*Client)(nil)
+	_ NetworkAPI  = (*Client)(nil)
+	_ StorageAPI  = (*Client)(nil)
+	_ SecurityAPI = (*Client)(nil)
+	_ ImageAPI    = (*Client)(nil)
+)
@@ -76,6 +76,9 @@ func (c Client) IpDetails(name string) (resp response.Ip, err error) {
 // to specific networks. Traffic can flow between instances within
 // the same IP network, but by default each network is isolated
 // from other networks and from the public Internet.
+// ipNetworkExchange could be "" if the network should not be
+// part of any exchange. If the client was configured with
+// ValidateIpNetworkExchange the exchange must already exist.
 func (c Client) CreateIp(
 	description string,
 	ipAddressPrefix string,
@@ -97,14 +100,15 @@ func (c Client) CreateIp(
 		return resp, errors.New("go-oracle-cloud: Empty ip network name")
 	}
 
+	if err = c.checkIpNetworkExchange(ipNetworkExchange); err != nil {
+		return resp, err
+	}
+
 	url := fmt.Sprintf("%s/network/v1/ipnetwork/", c.endpoint)
 
 	params := response.Ip{
 		Description:     description,
 		IpAddressPrefix: ipAddressPrefix,
-		IpNetworkExchange: fmt.Sprintf("/Compute-%s/%s/%s",
-			c.identify, c.username, ipNetworkExchange),
-
 		Name: fmt.Sprintf("/Compute-%s/%s/%s",
 			c.identify, c.username, name),
 
@@ -112,6 +116,11 @@ func (c Client) CreateIp(
 		PublicNaptEnabledFlag: publicNaptEnabledFlag,
 	}
 
+	if ipNetworkExchange != "" {
+		params.IpNetworkExchange = fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, ipNetworkExchange)
+	}
+
 	if err = request(paramsRequest{
 		client: &c.http,
 		cookie: c.cookie,
@@ -200,21 +209,27 @@ func (c Client) UpdateIp(
 		newName = currentName
 	}
 
+	if err = c.checkIpNetworkExchange(ipNetworkExchange); err != nil {
+		return resp, err
+	}
+
 	url := fmt.Sprintf("%s/network/v1/ipnetwork/Compute-%s/%s/%s",
 		c.endpoint, c.identify, c.username, currentName)
 
 	params := response.Ip{
 		Description:     description,
 		IpAddressPrefix: ipAddressPrefix,
-		IpNetworkExchange: fmt.Sprintf("/Compute-%s/%s/%s",
-			c.identify, c.username, ipNetworkExchange),
-
 		Name: fmt.Sprintf("/Compute-%s/%s/%s",
 			c.identify, c.username, newName),
 		Tags: tags,
 		PublicNaptEnabledFlag: publicNaptEnabledFlag,
 	}
 
+	if ipNetworkExchange != "" {
+		params.IpNetworkExchange = fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, ipNetworkExchange)
+	}
+
 	if err = request(paramsRequest{
 		client: &c.http,
 		cookie: c.cookie,
@@ -76,6 +76,7 @@ func (c Client) AllIpAddressAssociation() (resp response.AllIpAddressAssociation
 // to associate an IP address reservation, a public IP address,
 // with a vNIC of an instance either while creating the instance
 // or when an instance is already running.
+// The ip address reservation can be created with CreateIpAddressReservation.
 func (c Client) CreateIpAddressAssociation(
 	description string,
 	ipAddressReservation string,
@@ -0,0 +1,236 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// validatePrefixes checks if all the ip address prefixes
+// are valid IPv4 addresses in CIDR format
+func validatePrefixes(prefixes []string) error {
+	for _, prefix := range prefixes {
+		ip, _, err := net.ParseCIDR(prefix)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf(
+				"go-oracle-cloud: Invalid ip address prefix %q", prefix,
+			)
+		}
+	}
+
+	return nil
+}
+
+// CreateIpAddressPrefixSet creates an IP address prefix set.
+// An IP address prefix set can be used as the source or
+// destination of the traffic in a security rule.
+func (c Client) CreateIpAddressPrefixSet(
+	name string,
+	description string,
+	ipAddressPrefixes []string,
+	tags []string,
+) (resp response.IpAddressPrefixSet, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address prefix set name",
+		)
+	}
+
+	if err = validatePrefixes(ipAddressPrefixes); err != nil {
+		return resp, err
+	}
+
+	params := struct {
+		Name              string   `json:"name"`
+		Description       string   `json:"description,omitempty"`
+		IpAddressPrefixes []string `json:"ipAddressPrefixes"`
+		Tags              []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description:       description,
+		IpAddressPrefixes: ipAddressPrefixes,
+		Tags:              tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipaddressprefixset/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// DeleteIpAddressPrefixSet deletes the specified IP address prefix set.
+// Ensure that the prefix set is not referenced in any security rule.
+func (c Client) DeleteIpAddressPrefixSet(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty ip address prefix set name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipaddressprefixset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IpAddressPrefixSetDetails retrieves details of the specified IP address prefix set.
+func (c Client) IpAddressPrefixSetDetails(
+	name string,
+) (resp response.IpAddressPrefixSet, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address prefix set name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipaddressprefixset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllIpAddressPrefixSet retrieves details of all the IP address
+// prefix sets that are available in the account
+func (c Client) AllIpAddressPrefixSet() (resp response.AllIpAddressPrefixSet, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipaddressprefixset/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
+
+// UpdateIpAddressPrefixSet updates the description, tags and the
+// ip address prefixes of the specified IP address prefix set.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateIpAddressPrefixSet(
+	currentName string,
+	newName string,
+	description string,
+	ipAddressPrefixes []string,
+	tags []string,
+) (resp response.IpAddressPrefixSet, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if currentName == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address prefix set name",
+		)
+	}
+
+	if err = validatePrefixes(ipAddressPrefixes); err != nil {
+		return resp, err
+	}
+
+	if newName == "" {
+		newName = currentName
+	}
+
+	params := struct {
+		Name              string   `json:"name"`
+		Description       string   `json:"description,omitempty"`
+		IpAddressPrefixes []string `json:"ipAddressPrefixes"`
+		Tags              []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, newName),
+		Description:       description,
+		IpAddressPrefixes: ipAddressPrefixes,
+		Tags:              tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipaddressprefixset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, currentName)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
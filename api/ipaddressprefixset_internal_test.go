@@ -0,0 +1,25 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type ipAddressPrefixSetTest struct{}
+
+var _ = gc.Suite(&ipAddressPrefixSetTest{})
+
+func (i ipAddressPrefixSetTest) TestValidatePrefixes(c *gc.C) {
+	c.Check(validatePrefixes(nil), gc.IsNil)
+	c.Check(validatePrefixes([]string{"10.0.0.0/24", "0.0.0.0/0", "192.168.1.1/32"}), gc.IsNil)
+
+	for _, prefix := range []string{
+		"", "10.0.0.0", "10.0.0.0/33", "10.0.0/24", "300.0.0.0/8", "fd00::/8",
+	} {
+		err := validatePrefixes([]string{"10.0.0.0/24", prefix})
+		c.Check(err, gc.ErrorMatches,
+			`go-oracle-cloud: Invalid ip address prefix ".*"`)
+	}
+}
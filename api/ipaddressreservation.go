@@ -0,0 +1,258 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// validateIpAddressPool checks if the ip address pool is
+// one of the pools that the api provides
+func validateIpAddressPool(pool response.IpAddressPool) error {
+	switch pool {
+	case response.PublicIpPool, response.CloudIpPool:
+		return nil
+	default:
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid ip address pool %q", pool,
+		)
+	}
+}
+
+// stripIpAddressReservation strips all the names
+// that the ip address reservation references
+func stripIpAddressReservation(r *response.IpAddressReservation) {
+	strip(&r.Name)
+	pool := string(r.IpAddressPool)
+	strip(&pool)
+	r.IpAddressPool = response.IpAddressPool(pool)
+}
+
+// CreateIpAddressReservation creates an IP address reservation
+// for instances attached to ip networks. After creating an
+// IP address reservation, you can associate it with the vnic of
+// an instance by using the CreateIpAddressAssociation method.
+// If ipAddressPool is empty the reservation is made from
+// the public-ippool.
+func (c Client) CreateIpAddressReservation(
+	name string,
+	description string,
+	ipAddressPool response.IpAddressPool,
+	tags []string,
+) (resp response.IpAddressReservation, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address reservation name",
+		)
+	}
+
+	if ipAddressPool == "" {
+		ipAddressPool = response.PublicIpPool
+	}
+
+	if err = validateIpAddressPool(ipAddressPool); err != nil {
+		return resp, err
+	}
+
+	params := struct {
+		Name          string                 `json:"name"`
+		Description   string                 `json:"description,omitempty"`
+		IpAddressPool response.IpAddressPool `json:"ipAddressPool"`
+		Tags          []string               `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description: description,
+		IpAddressPool: response.IpAddressPool(
+			fmt.Sprintf("/oracle/public/%s", ipAddressPool),
+		),
+		Tags: tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipreservation/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripIpAddressReservation(&resp)
+
+	return resp, nil
+}
+
+// DeleteIpAddressReservation deletes the specified IP address reservation.
+// Ensure that no IP address association is using the reservation.
+func (c Client) DeleteIpAddressReservation(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty ip address reservation name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipreservation/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IpAddressReservationDetails retrieves details of the
+// specified IP address reservation.
+func (c Client) IpAddressReservationDetails(
+	name string,
+) (resp response.IpAddressReservation, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address reservation name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipreservation/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripIpAddressReservation(&resp)
+
+	return resp, nil
+}
+
+// AllIpAddressReservation retrieves details of all the IP address
+// reservations of ip networks that are available in the account
+func (c Client) AllIpAddressReservation() (resp response.AllIpAddressReservation, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipreservation/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		stripIpAddressReservation(&resp.Result[key])
+	}
+
+	return resp, nil
+}
+
+// UpdateIpAddressReservation updates the description, tags and
+// the ip address pool of the specified IP address reservation.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateIpAddressReservation(
+	currentName string,
+	newName string,
+	description string,
+	ipAddressPool response.IpAddressPool,
+	tags []string,
+) (resp response.IpAddressReservation, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if currentName == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip address reservation name",
+		)
+	}
+
+	if ipAddressPool == "" {
+		ipAddressPool = response.PublicIpPool
+	}
+
+	if err = validateIpAddressPool(ipAddressPool); err != nil {
+		return resp, err
+	}
+
+	if newName == "" {
+		newName = currentName
+	}
+
+	params := struct {
+		Name          string                 `json:"name"`
+		Description   string                 `json:"description,omitempty"`
+		IpAddressPool response.IpAddressPool `json:"ipAddressPool"`
+		Tags          []string               `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, newName),
+		Description: description,
+		IpAddressPool: response.IpAddressPool(
+			fmt.Sprintf("/oracle/public/%s", ipAddressPool),
+		),
+		Tags: tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipreservation/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, currentName)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripIpAddressReservation(&resp)
+
+	return resp, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// CreateIpNetworkExchange creates an IP network exchange.
+// After creating an IP network exchange you can add
+// IP networks to it with CreateIp or UpdateIp.
+func (c Client) CreateIpNetworkExchange(
+	name string,
+	description string,
+	tags []string,
+) (resp response.IpNetworkExchange, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip network exchange name",
+		)
+	}
+
+	params := struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description: description,
+		Tags:        tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipnetworkexchange/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// DeleteIpNetworkExchange deletes the specified IP network exchange.
+// Ensure that no IP network is added to the exchange before deleting it.
+func (c Client) DeleteIpNetworkExchange(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty ip network exchange name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipnetworkexchange/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IpNetworkExchangeDetails retrieves details of the specified IP network exchange.
+func (c Client) IpNetworkExchangeDetails(
+	name string,
+) (resp response.IpNetworkExchange, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty ip network exchange name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipnetworkexchange/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllIpNetworkExchange retrieves details of all the IP network
+// exchanges that are available in the account
+func (c Client) AllIpNetworkExchange() (resp response.AllIpNetworkExchange, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipnetworkexchange/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
+
+// checkIpNetworkExchange verifies that the ip network exchange
+// exists if the client was configured to do so. Only a 404 is
+// reported as a missing exchange, any other error is returned as is.
+func (c Client) checkIpNetworkExchange(name string) error {
+	if !c.validateExchange || name == "" {
+		return nil
+	}
+
+	url := fmt.Sprintf("%s/network/v1/ipnetworkexchange/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	return request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat: func(resp *http.Response) error {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf(
+					"go-oracle-cloud: Ip network exchange %s does not exist", name,
+				)
+			}
+			return defaultTreat(resp)
+		},
+	})
+}
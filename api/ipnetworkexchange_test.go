@@ -0,0 +1,67 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type ipNetworkExchangeTest struct{}
+
+var _ = gc.Suite(&ipNetworkExchangeTest{})
+
+// newValidatingClient returns a client authenticated against
+// the server that checks the ip network exchanges
+func newValidatingClient(c *gc.C, server *oracletest.Server) *api.Client {
+	cfg := server.Config()
+	cfg.ValidateIpNetworkExchange = true
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	return cli
+}
+
+func (i ipNetworkExchangeTest) TestValidateExchange(c *gc.C) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+	defer server.Close()
+	cli := newValidatingClient(c, server)
+
+	_, err := cli.CreateIp("", "192.168.0.0/24", "exchange", "net1", false, nil)
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Ip network exchange exchange does not exist")
+
+	_, err = cli.CreateIpNetworkExchange("exchange", "", nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateIp("", "192.168.0.0/24", "exchange", "net1", false, nil)
+	c.Assert(err, gc.IsNil)
+}
+
+func (i ipNetworkExchangeTest) TestValidateExchangeError(c *gc.C) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+	defer server.Close()
+	cli := newValidatingClient(c, server)
+
+	_, err := cli.CreateIpNetworkExchange("exchange", "", nil)
+	c.Assert(err, gc.IsNil)
+
+	// the errors other than 404 are not reported as a missing exchange
+	server.Inject(oracletest.InternalError(
+		"GET", "/network/v1/ipnetworkexchange/", "ref-1",
+	))
+
+	_, err = cli.CreateIp("", "192.168.0.0/24", "exchange", "net1", false, nil)
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 500 .*")
+
+	_, err = cli.UpdateIp("net1", "", "", "exchange", "192.168.0.0/24", false, nil)
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 500 .*")
+}
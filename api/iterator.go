@@ -0,0 +1,326 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// resultIterator streams the elements of the result array of a list
+// response, decoding them one at a time with the json tokens of the
+// body, so the memory stays flat no matter how many objects are listed.
+// The request is made on the first call of next.
+type resultIterator struct {
+	cfg     paramsRequest
+	body    io.ReadCloser
+	dec     *json.Decoder
+	started bool
+	done    bool
+	err     error
+}
+
+// newResultIterator returns an iterator over the result
+// array of the list response of the url
+func (c Client) newResultIterator(ctx context.Context, url string) *resultIterator {
+	it := &resultIterator{
+		cfg: paramsRequest{
+			ctx:    withStream(ctx),
+			client: &c.http,
+			cookie: c.cookie,
+			url:    url,
+			verb:   "GET",
+			treat:  defaultTreat,
+		},
+	}
+
+	if !c.isAuth() {
+		it.err = ErrNotAuth
+		it.done = true
+	}
+
+	return it
+}
+
+// next decodes the next element of the result array into v.
+// It returns false when there are no more elements or
+// an error occurred, and closes the response body.
+func (it *resultIterator) next(v interface{}) bool {
+	if it.done {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		if err := it.start(); err != nil {
+			it.fail(err)
+			return false
+		}
+		if it.done {
+			return false
+		}
+	}
+
+	if !it.dec.More() {
+		it.close()
+		return false
+	}
+
+	if err := it.dec.Decode(v); err != nil {
+		it.fail(err)
+		return false
+	}
+
+	return true
+}
+
+// start makes the request and advances the decoder
+// to the first element of the result array
+func (it *resultIterator) start() error {
+	resp, err := do(it.cfg)
+	if err != nil {
+		return err
+	}
+
+	it.body = resp.Body
+	it.dec = json.NewDecoder(resp.Body)
+
+	if err = expectDelim(it.dec, '{'); err != nil {
+		return err
+	}
+
+	for it.dec.More() {
+		tok, err := it.dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if key, _ := tok.(string); key == "result" {
+			return expectDelim(it.dec, '[')
+		}
+
+		// skip the value of the fields that are not the result
+		var skip json.RawMessage
+		if err = it.dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+
+	// the response has no result array
+	it.close()
+	return nil
+}
+
+// expectDelim reads the next token and checks that it's the delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid list response, expected %v got %v",
+			delim, tok,
+		)
+	}
+
+	return nil
+}
+
+// fail stops the iteration with the error
+func (it *resultIterator) fail(err error) {
+	it.err = err
+	it.close()
+}
+
+// close stops the iteration and closes the response body
+func (it *resultIterator) close() error {
+	it.done = true
+	if it.body == nil {
+		return nil
+	}
+
+	body := it.body
+	it.body = nil
+	return body.Close()
+}
+
+// InstanceIterator iterates over the instances of a list
+// response as they are decoded from the response body.
+//
+//	it := cli.ListInstances(ctx)
+//	defer it.Close()
+//	for it.Next() {
+//		instance := it.Instance()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type InstanceIterator struct {
+	it       *resultIterator
+	canned   []response.Instance
+	err      error
+	instance response.Instance
+}
+
+// NewInstanceIterator returns an iterator over the instances given,
+// that stops with err after them, if it's not nil. It could be used
+// to return canned results from the InstanceAPI mocks.
+// A nil InstanceIterator has no instances.
+func NewInstanceIterator(instances []response.Instance, err error) *InstanceIterator {
+	return &InstanceIterator{canned: instances, err: err}
+}
+
+// ListInstances returns an iterator over all the instances
+// in the account. Unlike AllInstances the instances are
+// decoded one at a time while the iterator advances.
+func (c Client) ListInstances(ctx context.Context) *InstanceIterator {
+	url := fmt.Sprintf("%s/instance/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	return &InstanceIterator{it: c.newResultIterator(ctx, url)}
+}
+
+// Next advances the iterator to the next instance.
+// It returns false when there are no more
+// instances or an error occurred.
+func (i *InstanceIterator) Next() bool {
+	if i == nil {
+		return false
+	}
+
+	if i.it == nil {
+		if len(i.canned) == 0 {
+			return false
+		}
+
+		i.instance, i.canned = i.canned[0], i.canned[1:]
+		return true
+	}
+
+	i.instance = response.Instance{}
+	if !i.it.next(&i.instance) {
+		return false
+	}
+
+	stripInstance(&i.instance)
+	return true
+}
+
+// Instance returns the current instance
+func (i *InstanceIterator) Instance() response.Instance {
+	if i == nil {
+		return response.Instance{}
+	}
+	return i.instance
+}
+
+// Err returns the error that stopped the iteration, if any
+func (i *InstanceIterator) Err() error {
+	switch {
+	case i == nil:
+		return nil
+	case i.it == nil && len(i.canned) == 0:
+		return i.err
+	case i.it == nil:
+		return nil
+	}
+	return i.it.err
+}
+
+// Close stops the iteration and releases the response.
+// It's safe to call it more than once.
+func (i *InstanceIterator) Close() error {
+	if i == nil || i.it == nil {
+		return nil
+	}
+	return i.it.close()
+}
+
+// StorageVolumeIterator iterates over the storage volumes of
+// a list response as they are decoded from the response body.
+type StorageVolumeIterator struct {
+	it     *resultIterator
+	canned []response.StorageVolume
+	err    error
+	volume response.StorageVolume
+}
+
+// NewStorageVolumeIterator returns an iterator over the volumes given,
+// that stops with err after them, if it's not nil. It could be used
+// to return canned results from the StorageAPI mocks.
+// A nil StorageVolumeIterator has no volumes.
+func NewStorageVolumeIterator(volumes []response.StorageVolume, err error) *StorageVolumeIterator {
+	return &StorageVolumeIterator{canned: volumes, err: err}
+}
+
+// ListStorageVolumes returns an iterator over all the storage
+// volumes in the account. Unlike AllStorageVolume the volumes
+// are decoded one at a time while the iterator advances.
+func (c Client) ListStorageVolumes(ctx context.Context) *StorageVolumeIterator {
+	url := fmt.Sprintf("%s/storage/volume/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	return &StorageVolumeIterator{it: c.newResultIterator(ctx, url)}
+}
+
+// Next advances the iterator to the next storage volume.
+// It returns false when there are no more
+// volumes or an error occurred.
+func (i *StorageVolumeIterator) Next() bool {
+	if i == nil {
+		return false
+	}
+
+	if i.it == nil {
+		if len(i.canned) == 0 {
+			return false
+		}
+
+		i.volume, i.canned = i.canned[0], i.canned[1:]
+		return true
+	}
+
+	i.volume = response.StorageVolume{}
+	if !i.it.next(&i.volume) {
+		return false
+	}
+
+	strip(&i.volume.Name)
+	return true
+}
+
+// StorageVolume returns the current storage volume
+func (i *StorageVolumeIterator) StorageVolume() response.StorageVolume {
+	if i == nil {
+		return response.StorageVolume{}
+	}
+	return i.volume
+}
+
+// Err returns the error that stopped the iteration, if any
+func (i *StorageVolumeIterator) Err() error {
+	switch {
+	case i == nil:
+		return nil
+	case i.it == nil && len(i.canned) == 0:
+		return i.err
+	case i.it == nil:
+		return nil
+	}
+	return i.it.err
+}
+
+// Close stops the iteration and releases the response.
+// It's safe to call it more than once.
+func (i *StorageVolumeIterator) Close() error {
+	if i == nil || i.it == nil {
+		return nil
+	}
+	return i.it.close()
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"context"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	gc "gopkg.in/check.v1"
+)
+
+type iteratorTest struct{}
+
+var _ = gc.Suite(&iteratorTest{})
+
+func (i iteratorTest) TestListInstances(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	var params api.InstanceParams
+	for _, name := range []string{"web", "db", "cache"} {
+		instance, err := api.NewInstanceBuilder(name, name, "oc3").
+			Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+			Build()
+		c.Assert(err, gc.IsNil)
+		params.Instances = append(params.Instances, instance)
+	}
+
+	_, err := cli.CreateInstance(params)
+	c.Assert(err, gc.IsNil)
+
+	all, err := cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+
+	it := cli.ListInstances(context.Background())
+	defer it.Close()
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Instance().Name)
+	}
+	c.Assert(it.Err(), gc.IsNil)
+	c.Assert(names, gc.HasLen, 3)
+
+	for key, name := range names {
+		c.Assert(name, gc.Equals, all.Result[key].Name)
+	}
+
+	c.Assert(it.Next(), gc.Equals, false)
+}
+
+func (i iteratorTest) TestListErrors(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := cli.ListStorageVolumes(ctx)
+	c.Assert(it.Next(), gc.Equals, false)
+	c.Assert(it.Err(), gc.ErrorMatches, ".*context canceled")
+
+	unauth, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+
+	it = unauth.ListStorageVolumes(context.Background())
+	c.Assert(it.Next(), gc.Equals, false)
+	c.Assert(it.Err(), gc.Equals, api.ErrNotAuth)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hoenirvili/go-oracle-cloud/response"
 )
@@ -42,11 +43,158 @@ type Instances struct {
 	// These tags aren’t available from within the instance.
 	Tags []string `json:"tags,omitempty"`
 
-	Attributes []map[string]interface{} `json:"attributes,omitempty"`
+	// Attributes are the instance attributes, like the userdata
+	// that is passed to the instance. Use the Userdata type
+	// to build the userdata attributes.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// Networking holds the network interfaces of the instance,
+	// from eth0 to eth7. Every interface could be attached either
+	// to the shared network or to an ip network.
+	// If it's nil the instance will have only eth0 attached to
+	// the shared network.
+	Networking *response.Networking `json:"networking,omitempty"`
 
 	// If set to true (default), then reverse DNS records are created.
 	// If set to false, no reverse DNS records are created.
-	Reverse_dns bool `json:"reverse_dns,omiempty"`
+	// If it's nil the default is used.
+	Reverse_dns *bool `json:"reverse_dns,omitempty"`
+
+	// Entry is the imagelist entry to be used when the instance
+	// is launched. If it's 0 the default entry of the
+	// imagelist is used.
+	Entry int `json:"entry,omitempty"`
+
+	// Storage_attachments holds the storage volumes that
+	// will be attached to the instance when it's launched.
+	Storage_attachments []StorageAttachment `json:"storage_attachments,omitempty"`
+
+	// Boot_order is the index of the storage attachment
+	// from which the instance boots. If it's set the
+	// instance boots from a bootable storage volume and
+	// the imagelist could be omitted.
+	Boot_order []int `json:"boot_order,omitempty"`
+
+	// Placement_requirements is a list of placement requirements
+	// that the node of the instance must satisfy.
+	Placement_requirements []string `json:"placement_requirements,omitempty"`
+
+	// Priority is the priority of the instance, for example
+	// /oracle/public/default
+	Priority string `json:"priority,omitempty"`
+
+	// Desired_state is the state in which the instance should
+	// be after it's launched, running or shutdown.
+	// If it's empty the instance will be running.
+	Desired_state string `json:"desired_state,omitempty"`
+}
+
+// StorageAttachment is a storage volume that will be
+// attached to an instance when it's launched
+type StorageAttachment struct {
+	// Index is the index of the attachment, from 1 to 10.
+	// The index determines the device name by which the
+	// volume is exposed to the instance.
+	Index int `json:"index"`
+
+	// Volume is the name of the storage volume
+	Volume string `json:"volume"`
+}
+
+// validate checks if the combination of the
+// instance params is one that the api accepts
+func (i Instances) validate() error {
+	if i.Shape == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty shape in instance parameters",
+		)
+	}
+
+	if i.Label == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty label in instance parameters",
+		)
+	}
+
+	if i.Imagelist == "" && len(i.Boot_order) == 0 {
+		return errors.New(
+			"go-oracle-cloud: Empty image list in instance parameters",
+		)
+	}
+
+	if i.Entry < 0 {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid image list entry %d in instance parameters",
+			i.Entry,
+		)
+	}
+
+	if i.Entry > 0 && i.Imagelist == "" {
+		return errors.New(
+			"go-oracle-cloud: Image list entry requires an image list in instance parameters",
+		)
+	}
+
+	indexes := make(map[int]bool)
+	for _, attachment := range i.Storage_attachments {
+		if attachment.Index < 1 || attachment.Index > 10 {
+			return fmt.Errorf(
+				"go-oracle-cloud: Invalid storage attachment index %d, it should be from 1 to 10",
+				attachment.Index,
+			)
+		}
+
+		if attachment.Volume == "" {
+			return fmt.Errorf(
+				"go-oracle-cloud: Empty volume of storage attachment %d",
+				attachment.Index,
+			)
+		}
+
+		if indexes[attachment.Index] {
+			return fmt.Errorf(
+				"go-oracle-cloud: Duplicate storage attachment index %d",
+				attachment.Index,
+			)
+		}
+
+		indexes[attachment.Index] = true
+	}
+
+	for _, index := range i.Boot_order {
+		if !indexes[index] {
+			return fmt.Errorf(
+				"go-oracle-cloud: Boot order %d requires a bootable storage attachment with the same index",
+				index,
+			)
+		}
+	}
+
+	if i.Networking != nil {
+		for name, nic := range i.Networking.Interfaces() {
+			shared := nic.Vethernet != "" || len(nic.Seclists) != 0
+			ipnetwork := nic.Ipnetwork != "" || nic.Vnic != "" ||
+				len(nic.Vnicsets) != 0 || nic.Is_default_gateway
+
+			if shared && ipnetwork {
+				return fmt.Errorf(
+					"go-oracle-cloud: Interface %s can't be attached both to the shared network and to an ip network",
+					name,
+				)
+			}
+		}
+	}
+
+	switch i.Desired_state {
+	case "", "running", "shutdown":
+	default:
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid desired state %q, it should be running or shutdown",
+			i.Desired_state,
+		)
+	}
+
+	return nil
 }
 
 // InstanceParams used to feed the CreateInstance function
@@ -55,6 +203,10 @@ type InstanceParams struct {
 	Instances     []Instances `json:"instances"`
 }
 
+// CreateInstance creates the instances described in params by
+// posting a launch plan. The params of every instance are validated
+// before posting, the InstanceBuilder could be used to build them.
+// The names in params are qualified in a copy, params is not changed.
 func (c Client) CreateInstance(params InstanceParams) (resp response.LaunchPlan, err error) {
 	if params.Instances == nil || len(params.Instances) == 0 {
 		return resp, errors.New("go-oracle-cloud: Empty slice of instance parameters")
@@ -66,24 +218,22 @@ func (c Client) CreateInstance(params InstanceParams) (resp response.LaunchPlan,
 
 	n := len(params.Instances)
 
+	// the names are qualified in a copy of the instances
+	// so the params of the caller are left as they are
+	instances := make([]Instances, n)
+	for i := range params.Instances {
+		instances[i] = params.Instances[i].clone()
+	}
+	params.Instances = instances
+
 	// here we are constructing the post body json
 	for i := 0; i < n; i++ {
-		// add the imagelist
-		if params.Instances[i].Imagelist == "" {
-			return resp, errors.New(
-				"go-oracle-cloud: Empty image list in instance parameters",
-			)
+		if err = params.Instances[i].validate(); err != nil {
+			return resp, err
 		}
-		params.Instances[i].Imagelist = fmt.Sprintf(
-			"/Compute-%s/%s/%s", c.identify, c.username, params.Instances[i].Imagelist,
-		)
 
-		// add the label
-		if params.Instances[i].Label == "" {
-			return resp, errors.New(
-				"go-oracle-cloud: Empty label in instance parameters",
-			)
-		}
+		// add the imagelist
+		c.qualify(&params.Instances[i].Imagelist)
 
 		// make the name oracle cloud complaint
 		params.Instances[i].Name = fmt.Sprintf("/Compute-%s/%s/%s",
@@ -96,6 +246,16 @@ func (c Client) CreateInstance(params InstanceParams) (resp response.LaunchPlan,
 				c.identify, c.username, params.Instances[i].SSHKeys[j],
 			)
 		}
+
+		// add the storage volumes
+		for j := range params.Instances[i].Storage_attachments {
+			c.qualify(&params.Instances[i].Storage_attachments[j].Volume)
+		}
+
+		// make the names of the networking interfaces oracle cloud complaint
+		if params.Instances[i].Networking != nil {
+			c.qualifyNetworking(params.Instances[i].Networking)
+		}
 	}
 
 	url := fmt.Sprintf("%s/launchplan/", c.endpoint)
@@ -147,3 +307,74 @@ func (c Client) CreateInstance(params InstanceParams) (resp response.LaunchPlan,
 
 	return resp, nil
 }
+
+// clone returns a copy of the instance params that
+// doesn't share the names that CreateInstance qualifies
+func (i Instances) clone() Instances {
+	i.SSHKeys = append([]string(nil), i.SSHKeys...)
+	i.Storage_attachments = append([]StorageAttachment(nil), i.Storage_attachments...)
+
+	if i.Networking != nil {
+		networking := *i.Networking
+		for _, nic := range []**response.Nic{
+			&networking.Eth0, &networking.Eth1, &networking.Eth2, &networking.Eth3,
+			&networking.Eth4, &networking.Eth5, &networking.Eth6, &networking.Eth7,
+		} {
+			if *nic == nil {
+				continue
+			}
+			copied := **nic
+			copied.Seclists = append([]string(nil), copied.Seclists...)
+			copied.Vnicsets = append([]string(nil), copied.Vnicsets...)
+			copied.Nat = append(response.Nat(nil), copied.Nat...)
+			*nic = &copied
+		}
+		i.Networking = &networking
+	}
+
+	return i
+}
+
+// qualify makes the name oracle cloud complaint if
+// it's not already a multipart name
+func (c Client) qualify(name *string) {
+	if *name == "" || strings.HasPrefix(*name, "/") {
+		return
+	}
+
+	*name = fmt.Sprintf("/Compute-%s/%s/%s",
+		c.identify, c.username, *name)
+}
+
+// qualifyNetworking makes all the names of the networking
+// interfaces oracle cloud complaint
+func (c Client) qualifyNetworking(networking *response.Networking) {
+	for _, nic := range networking.Interfaces() {
+		for key := range nic.Seclists {
+			c.qualify(&nic.Seclists[key])
+		}
+		c.qualify(&nic.Ipnetwork)
+		c.qualify(&nic.Vnic)
+		for key := range nic.Vnicsets {
+			c.qualify(&nic.Vnicsets[key])
+		}
+		for key := range nic.Nat {
+			c.qualifyNat(&nic.Nat[key])
+		}
+	}
+}
+
+// qualifyNat makes the ip reservation name of the nat entry
+// oracle cloud complaint, like ipreservation:name for the shared
+// network or network/v1/ipreservation:name for ip networks.
+// The ip pools, like ippool:/oracle/public/ippool, are left as they are.
+func (c Client) qualifyNat(entry *string) {
+	i := strings.Index(*entry, ":")
+	if i < 0 || !strings.HasSuffix((*entry)[:i], "ipreservation") {
+		return
+	}
+
+	name := (*entry)[i+1:]
+	c.qualify(&name)
+	*entry = (*entry)[:i+1] + name
+}
@@ -0,0 +1,248 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The environment variables read by LoadConfig
+const (
+	EnvEndpoint   = "OPC_ENDPOINT"
+	EnvIdentify   = "OPC_IDENTITY_DOMAIN"
+	EnvUsername   = "OPC_USERNAME"
+	EnvPassword   = "OPC_PASSWORD"
+	EnvProfile    = "OPC_PROFILE"
+	EnvConfigFile = "OPC_CONFIG_FILE"
+)
+
+// DefaultProfile is the profile used when no profile is given
+const DefaultProfile = "default"
+
+// DefaultConfigFile returns the path of the profile file
+// used when OPC_CONFIG_FILE is not set, that is .opc/config
+// in the home directory of the user
+func DefaultConfigFile() string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		home = os.Getenv("USERPROFILE")
+	}
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".opc", "config")
+}
+
+// LoadConfig returns the configuration of the client read from
+// the profile file and from the environment.
+// The profile file is given by OPC_CONFIG_FILE or else it's
+// DefaultConfigFile, that is not required to exist.
+// The profile is the one given, or else the one in OPC_PROFILE
+// or else the DefaultProfile, that is not required to be
+// in the profile file.
+// The OPC_ENDPOINT, OPC_IDENTITY_DOMAIN, OPC_USERNAME and
+// OPC_PASSWORD environment variables take precedence over
+// the values of the profile.
+// The configuration is validated like in NewClient.
+//
+// LoadConfig never sets Config.Credentials, so the password must be
+// in the profile or in OPC_PASSWORD. To take the password from another
+// credential provider, like CommandCredentials or EncryptedFileCredentials,
+// read the rest of the configuration with ConfigFromFile or ConfigFromEnv
+// and set Credentials before calling NewClient.
+func LoadConfig(profile string) (cfg Config, err error) {
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+
+	explicitProfile := profile != ""
+	if !explicitProfile {
+		profile = DefaultProfile
+	}
+
+	path := os.Getenv(EnvConfigFile)
+	explicitPath := path != ""
+	if !explicitPath {
+		path = DefaultConfigFile()
+	}
+
+	if path != "" {
+		profiles, err := readProfiles(path)
+		switch {
+		case err == nil:
+			values, ok := profiles[profile]
+			if !ok && explicitProfile {
+				return Config{}, fmt.Errorf(
+					"go-oracle-cloud: Profile %q not found in %s", profile, path,
+				)
+			}
+			// the default profile is optional like the default file
+			cfg = profileConfig(values)
+		case os.IsNotExist(err) && !explicitPath:
+			// the default profile file is optional
+		default:
+			return Config{}, err
+		}
+	}
+
+	cfg = mergeConfig(cfg, ConfigFromEnv())
+
+	if err = cfg.validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// ConfigFromEnv returns the configuration that
+// is set in the environment variables
+func ConfigFromEnv() Config {
+	return Config{
+		Endpoint: os.Getenv(EnvEndpoint),
+		Identify: os.Getenv(EnvIdentify),
+		Username: os.Getenv(EnvUsername),
+		Password: os.Getenv(EnvPassword),
+	}
+}
+
+// ConfigFromFile returns the configuration of the profile that is
+// in the profile file at path. The file is in the ini format, with a
+// section for every profile:
+//
+//	[default]
+//	endpoint = https://api-z52.compute.us2.oraclecloud.com
+//	identity_domain = qbitq
+//	username = oracle@username.com
+//	password = oraclepassword
+//
+// The configuration is not validated.
+func ConfigFromFile(path, profile string) (cfg Config, err error) {
+	profiles, err := readProfiles(path)
+	if err != nil {
+		return cfg, err
+	}
+
+	values, ok := profiles[profile]
+	if !ok {
+		return cfg, fmt.Errorf(
+			"go-oracle-cloud: Profile %q not found in %s", profile, path,
+		)
+	}
+
+	return profileConfig(values), nil
+}
+
+// readProfiles reads and parses the profile file at path
+func readProfiles(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseProfiles(f)
+	if err != nil {
+		return nil, fmt.Errorf("go-oracle-cloud: %s: %v", path, err)
+	}
+
+	return profiles, nil
+}
+
+// profileConfig returns the configuration of the profile values
+func profileConfig(values map[string]string) Config {
+	return Config{
+		Endpoint: values["endpoint"],
+		Identify: values["identity_domain"],
+		Username: values["username"],
+		Password: values["password"],
+	}
+}
+
+// profileKeys are the keys that a profile can have
+var profileKeys = map[string]bool{
+	"endpoint":        true,
+	"identity_domain": true,
+	"username":        true,
+	"password":        true,
+}
+
+// parseProfiles parses the ini profiles of r
+func parseProfiles(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+
+	var section map[string]string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", line[0] == '#', line[0] == ';':
+			continue
+		case line[0] == '[':
+			if line[len(line)-1] != ']' {
+				return nil, fmt.Errorf("line %d: Invalid section %q", n, line)
+			}
+
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: Empty profile name", n)
+			}
+
+			if section = profiles[name]; section == nil {
+				section = make(map[string]string)
+				profiles[name] = section
+			}
+			continue
+		}
+
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: Expected key = value", n)
+		}
+
+		if section == nil {
+			return nil, fmt.Errorf("line %d: Key outside of a profile", n)
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:i]))
+		if !profileKeys[key] {
+			return nil, fmt.Errorf("line %d: Unknown key %q", n, key)
+		}
+
+		value := strings.TrimSpace(line[i+1:])
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') &&
+			value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		section[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// mergeConfig returns cfg with the values that are set in override
+func mergeConfig(cfg, override Config) Config {
+	if override.Endpoint != "" {
+		cfg.Endpoint = override.Endpoint
+	}
+	if override.Identify != "" {
+		cfg.Identify = override.Identify
+	}
+	if override.Username != "" {
+		cfg.Username = override.Username
+	}
+	if override.Password != "" {
+		cfg.Password = override.Password
+	}
+	return cfg
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	gc "gopkg.in/check.v1"
+)
+
+type loadConfigTest struct {
+	env  map[string]string
+	path string
+}
+
+var _ = gc.Suite(&loadConfigTest{})
+
+const testProfiles = `
+# oracle cloud profiles
+[default]
+endpoint = https://api-z52.compute.us2.oraclecloud.com
+identity_domain = qbitq
+username = oracle@username.com
+password = "oraclepassword"
+
+[staging]
+endpoint = https://api-z27.compute.us6.oraclecloud.com
+identity_domain = staging
+username = staging@username.com
+password = stagingpassword
+`
+
+var configEnv = []string{
+	api.EnvEndpoint, api.EnvIdentify, api.EnvUsername,
+	api.EnvPassword, api.EnvProfile, api.EnvConfigFile,
+}
+
+func (l *loadConfigTest) SetUpTest(c *gc.C) {
+	l.env = make(map[string]string)
+	for _, key := range configEnv {
+		l.env[key] = os.Getenv(key)
+		os.Unsetenv(key)
+	}
+
+	l.path = filepath.Join(c.MkDir(), "config")
+	err := ioutil.WriteFile(l.path, []byte(testProfiles), 0600)
+	c.Assert(err, gc.IsNil)
+	os.Setenv(api.EnvConfigFile, l.path)
+}
+
+func (l *loadConfigTest) TearDownTest(c *gc.C) {
+	for key, value := range l.env {
+		if value == "" {
+			os.Unsetenv(key)
+		} else {
+			os.Setenv(key, value)
+		}
+	}
+}
+
+func (l *loadConfigTest) TestProfiles(c *gc.C) {
+	cfg, err := api.LoadConfig("")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg, gc.DeepEquals, api.Config{
+		Endpoint: "https://api-z52.compute.us2.oraclecloud.com",
+		Identify: "qbitq",
+		Username: "oracle@username.com",
+		Password: "oraclepassword",
+	})
+
+	os.Setenv(api.EnvProfile, "staging")
+	cfg, err = api.LoadConfig("")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg.Identify, gc.Equals, "staging")
+
+	cfg, err = api.LoadConfig("default")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg.Identify, gc.Equals, "qbitq")
+
+	_, err = api.LoadConfig("production")
+	c.Assert(err, gc.ErrorMatches,
+		`go-oracle-cloud: Profile "production" not found in .*`)
+}
+
+func (l *loadConfigTest) TestEnvPrecedence(c *gc.C) {
+	os.Setenv(api.EnvUsername, "env@username.com")
+	os.Setenv(api.EnvPassword, "envpassword")
+
+	cfg, err := api.LoadConfig("staging")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg, gc.DeepEquals, api.Config{
+		Endpoint: "https://api-z27.compute.us6.oraclecloud.com",
+		Identify: "staging",
+		Username: "env@username.com",
+		Password: "envpassword",
+	})
+}
+
+func (l *loadConfigTest) TestEnvOnly(c *gc.C) {
+	os.Setenv(api.EnvConfigFile, filepath.Join(c.MkDir(), "missing"))
+	_, err := api.LoadConfig("")
+	c.Assert(os.IsNotExist(err), gc.Equals, true)
+
+	// without a profile file in the home directory
+	// the configuration is read only from the environment
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	os.Setenv("HOME", c.MkDir())
+	os.Unsetenv(api.EnvConfigFile)
+	os.Setenv(api.EnvEndpoint, "https://api-z52.compute.us2.oraclecloud.com")
+	os.Setenv(api.EnvIdentify, "qbitq")
+	os.Setenv(api.EnvUsername, "oracle@username.com")
+
+	_, err = api.LoadConfig("")
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Empty client password")
+
+	os.Setenv(api.EnvPassword, "oraclepassword")
+	cfg, err := api.LoadConfig("")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg.Identify, gc.Equals, "qbitq")
+}
+
+func (l *loadConfigTest) TestEnvWithoutDefaultProfile(c *gc.C) {
+	// a profile file without the default profile
+	err := ioutil.WriteFile(l.path, []byte(`
+[staging]
+endpoint = https://api-z27.compute.us6.oraclecloud.com
+identity_domain = staging
+username = staging@username.com
+password = stagingpassword
+`), 0600)
+	c.Assert(err, gc.IsNil)
+
+	os.Setenv(api.EnvEndpoint, "https://api-z52.compute.us2.oraclecloud.com")
+	os.Setenv(api.EnvIdentify, "qbitq")
+	os.Setenv(api.EnvUsername, "oracle@username.com")
+	os.Setenv(api.EnvPassword, "oraclepassword")
+
+	want := api.Config{
+		Endpoint: "https://api-z52.compute.us2.oraclecloud.com",
+		Identify: "qbitq",
+		Username: "oracle@username.com",
+		Password: "oraclepassword",
+	}
+
+	cfg, err := api.LoadConfig("")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg, gc.DeepEquals, want)
+
+	// the same file in the home directory
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	os.Setenv("HOME", c.MkDir())
+	os.Unsetenv(api.EnvConfigFile)
+	err = os.Mkdir(filepath.Dir(api.DefaultConfigFile()), 0700)
+	c.Assert(err, gc.IsNil)
+	err = os.Rename(l.path, api.DefaultConfigFile())
+	c.Assert(err, gc.IsNil)
+
+	cfg, err = api.LoadConfig("")
+	c.Assert(err, gc.IsNil)
+	c.Assert(cfg, gc.DeepEquals, want)
+
+	// a profile that is given explicitly is still required
+	_, err = api.LoadConfig("default")
+	c.Assert(err, gc.ErrorMatches,
+		`go-oracle-cloud: Profile "default" not found in .*`)
+
+	os.Setenv(api.EnvProfile, "production")
+	_, err = api.LoadConfig("")
+	c.Assert(err, gc.ErrorMatches,
+		`go-oracle-cloud: Profile "production" not found in .*`)
+}
+
+func (l *loadConfigTest) TestInvalidFile(c *gc.C) {
+	for _, test := range []struct {
+		content string
+		err     string
+	}{{
+		content: "endpoint = https://oracle.com",
+		err:     ".*: line 1: Key outside of a profile",
+	}, {
+		content: "[default\nendpoint = https://oracle.com",
+		err:     `.*: line 1: Invalid section "\[default"`,
+	}, {
+		content: "[default]\nendpoint",
+		err:     ".*: line 2: Expected key = value",
+	}, {
+		content: "[default]\nidentify = qbitq",
+		err:     `.*: line 2: Unknown key "identify"`,
+	}} {
+		err := ioutil.WriteFile(l.path, []byte(test.content), 0600)
+		c.Assert(err, gc.IsNil)
+
+		_, err = api.ConfigFromFile(l.path, "default")
+		c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: "+test.err)
+	}
+}
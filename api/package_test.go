@@ -4,11 +4,87 @@
 package api_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
 	"testing"
 
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
 	gc "gopkg.in/check.v1"
 )
 
 func TestPackage(t *testing.T) {
 	gc.TestingT(t)
 }
+
+// newFakeClient starts a fake oracle server and returns
+// it together with a client authenticated against it.
+// The caller should close the server when finished.
+func newFakeClient(c *gc.C) (*oracletest.Server, *api.Client) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	return server, cli
+}
+
+// bodyRecorder records the json bodies of the
+// requests made by a client to the fake server
+type bodyRecorder struct {
+	mu     sync.Mutex
+	bodies map[string]map[string]interface{}
+}
+
+// RoundTrip records the body of the request and makes it
+func (b *bodyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		raw, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(raw))
+
+		var body map[string]interface{}
+		if json.Unmarshal(raw, &body) == nil {
+			b.mu.Lock()
+			b.bodies[req.Method+" "+req.URL.Path] = body
+			b.mu.Unlock()
+		}
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// body returns the body of the last request
+// made with the method to the path
+func (b *bodyRecorder) body(method, path string) map[string]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bodies[method+" "+path]
+}
+
+// newRecordingClient is like newFakeClient but it also
+// returns the recorder of the bodies of the client requests
+func newRecordingClient(c *gc.C) (*oracletest.Server, *api.Client, *bodyRecorder) {
+	server := oracletest.NewServer(
+		"myIdentify", "oracleusername@oracle.com", "Password123",
+	)
+
+	recorder := &bodyRecorder{bodies: make(map[string]map[string]interface{})}
+	cfg := server.Config()
+	cfg.Transport = recorder
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	return server, cli, recorder
+}
@@ -6,6 +6,7 @@ package api
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hoenirvili/go-oracle-cloud/response"
 )
@@ -55,8 +56,7 @@ func (c Client) CreateRebootInstanceRequest(
 		return resp, err
 	}
 
-	strip(&resp.Name)
-	strip(&resp.Instance)
+	c.stripRebootInstanceRequest(&resp)
 
 	return resp, nil
 }
@@ -118,8 +118,7 @@ func (c Client) RebootInstanceRequestDetails(
 		return resp, err
 	}
 
-	strip(&resp.Name)
-	strip(&resp.Instance)
+	c.stripRebootInstanceRequest(&resp)
 
 	return resp, nil
 }
@@ -130,7 +129,7 @@ func (c Client) AllRebootInstanceRequest() (resp response.AllRebootInstanceReque
 		return resp, ErrNotAuth
 	}
 
-	url := fmt.Sprintf("%s/rebootinstancerequest/Compute-%s/%s",
+	url := fmt.Sprintf("%s/rebootinstancerequest/Compute-%s/%s/",
 		c.endpoint, c.identify, c.username)
 
 	if err = request(paramsRequest{
@@ -145,9 +144,19 @@ func (c Client) AllRebootInstanceRequest() (resp response.AllRebootInstanceReque
 	}
 
 	for key := range resp.Result {
-		strip(&resp.Result[key].Name)
-		strip(&resp.Result[key].Instance)
+		c.stripRebootInstanceRequest(&resp.Result[key])
 	}
 
 	return resp, nil
 }
+
+// stripRebootInstanceRequest strips the container from the names of
+// the reboot instance request. The name of the request is in the form
+// of instance-name/instance-id/request-id so only the container is
+// stripped, in order to use it with RebootInstanceRequestDetails
+// and DeleteRebootInstanceRequest.
+func (c Client) stripRebootInstanceRequest(r *response.RebootInstanceRequest) {
+	container := fmt.Sprintf("/Compute-%s/%s/", c.identify, c.username)
+	r.Name = strings.TrimPrefix(r.Name, container)
+	r.Instance = strings.TrimPrefix(r.Instance, container)
+}
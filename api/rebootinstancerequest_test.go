@@ -0,0 +1,56 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	gc "gopkg.in/check.v1"
+)
+
+type rebootInstanceRequestTest struct{}
+
+var _ = gc.Suite(&rebootInstanceRequestTest{})
+
+func (r rebootInstanceRequestTest) TestRoundTrip(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.IsNil)
+
+	all, err := cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+	name := all.Result[0].Name
+
+	// the names keep the instance and the request id
+	reboot, err := cli.CreateRebootInstanceRequest(true, name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(reboot.Instance, gc.Equals, name)
+	c.Assert(strings.HasPrefix(reboot.Name, name+"/"), gc.Equals, true)
+
+	details, err := cli.RebootInstanceRequestDetails(reboot.Name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(details.Name, gc.Equals, reboot.Name)
+	c.Assert(details.Hard, gc.Equals, true)
+
+	requests, err := cli.AllRebootInstanceRequest()
+	c.Assert(err, gc.IsNil)
+	c.Assert(requests.Result, gc.HasLen, 1)
+	c.Assert(requests.Result[0].Name, gc.Equals, reboot.Name)
+
+	c.Assert(cli.DeleteRebootInstanceRequest(reboot.Name), gc.IsNil)
+
+	requests, err = cli.AllRebootInstanceRequest()
+	c.Assert(err, gc.IsNil)
+	c.Assert(requests.Result, gc.HasLen, 0)
+}
@@ -5,6 +5,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -61,6 +62,8 @@ func defaultDeleteTreat(resp *http.Response) (err error) {
 
 // paramsRequest used to fill up the params for the request function
 type paramsRequest struct {
+	// ctx if it's not nil is the context of the request
+	ctx context.Context
 	// directory is the type of directory request
 	directory bool
 	// use this client to do the request
@@ -85,6 +88,34 @@ type paramsRequest struct {
 // request function is a wrapper around building the request,
 // treating exceptional errors and executing the client http connection
 func request(cfg paramsRequest) (err error) {
+	resp, err := do(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := resp.Body.Close(); errClose != nil {
+			// overwrite the previous error if any
+			err = errClose
+		}
+	}()
+
+	// if we the caller tells us that the http request
+	// returns an response and wants to decode the response
+	// that is json format.
+	if cfg.resp != nil {
+		if err = json.NewDecoder(resp.Body).Decode(cfg.resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// do builds and executes the request and treats the response,
+// returning it with the body unread. The caller must close the
+// body of the response. This is used by the requests that
+// stream the response instead of decoding it at once.
+func do(cfg paramsRequest) (*http.Response, error) {
 	var buf io.Reader
 
 	// if we have a body we assume that the body
@@ -93,14 +124,18 @@ func request(cfg paramsRequest) (err error) {
 	if cfg.body != nil {
 		raw, err := json.Marshal(cfg.body)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		buf = bytes.NewBuffer(raw)
 	}
 
 	req, err := http.NewRequest(cfg.verb, cfg.url, buf)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if cfg.ctx != nil {
+		req = req.WithContext(cfg.ctx)
 	}
 
 	// add the session cookie if there is one
@@ -132,33 +167,19 @@ func request(cfg paramsRequest) (err error) {
 
 	resp, err := cfg.client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		if errClose := resp.Body.Close(); errClose != nil {
-			// overwrite the previous error if any
-			err = errClose
-		}
-	}()
 
 	// if we have a special treat function
 	// let the caller treat the response
 	if cfg.treat != nil {
 		if err = cfg.treat(resp); err != nil {
-			return err
+			resp.Body.Close()
+			return nil, err
 		}
 	}
 
-	// if we the caller tells us that the http request
-	// returns an response and wants to decode the response
-	// that is json format.
-	if cfg.resp != nil {
-		if err = json.NewDecoder(resp.Body).Decode(cfg.resp); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return resp, nil
 }
 
 // strip strips all metadata from a string
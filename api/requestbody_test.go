@@ -0,0 +1,66 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type requestBodyTest struct{}
+
+var _ = gc.Suite(&requestBodyTest{})
+
+// serverFields are the fields that only the server sets
+var serverFields = []string{"uri", "ipAddress"}
+
+func (r requestBodyTest) TestIpNetworkBodies(c *gc.C) {
+	server, cli, recorder := newRecordingClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateVnicSet("web", "", []string{"web/eth1"}, nil, nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateIpAddressPrefixSet("office", "", []string{"10.0.0.0/24"}, nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateSecurityProtocol("http", "", "tcp", nil, []string{"80"}, nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateSecurityRule(api.SecurityRuleParams{
+		Name:                   "http",
+		FlowDirection:          response.Ingress,
+		DstVnicSet:             "web",
+		SrcIpAddressPrefixSets: []string{"office"},
+		SecProtocols:           []string{"http"},
+		EnabledFlag:            true,
+	})
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateRoute("default", "", 0, "0.0.0.0/0", "web", nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateIpAddressReservation("public", "", response.PublicIpPool, nil)
+	c.Assert(err, gc.IsNil)
+
+	for _, path := range []string{
+		"/network/v1/vnicset/",
+		"/network/v1/ipaddressprefixset/",
+		"/network/v1/secprotocol/",
+		"/network/v1/secrule/",
+		"/network/v1/route/",
+		"/network/v1/ipreservation/",
+	} {
+		body := recorder.body("POST", path)
+		c.Assert(body, gc.NotNil, gc.Commentf("no request to %s", path))
+		c.Assert(body["name"], gc.Matches,
+			"/Compute-myIdentify/oracleusername@oracle.com/.*")
+
+		for _, field := range serverFields {
+			_, ok := body[field]
+			c.Check(ok, gc.Equals, false, gc.Commentf("%s %s", path, field))
+		}
+	}
+}
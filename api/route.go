@@ -0,0 +1,259 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// validateRoute checks if the route params are ones that the api accepts
+func validateRoute(
+	adminDistance int,
+	ipAddressPrefix string,
+	nextHopVnicSet string,
+) error {
+	if adminDistance < 0 || adminDistance > 2 {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid route admin distance %d, it should be 0, 1 or 2",
+			adminDistance,
+		)
+	}
+
+	if ipAddressPrefix == "" {
+		return errors.New("go-oracle-cloud: Empty route ip address prefix")
+	}
+
+	if err := validatePrefixes([]string{ipAddressPrefix}); err != nil {
+		return err
+	}
+
+	if nextHopVnicSet == "" {
+		return errors.New("go-oracle-cloud: Empty next hop vnic set name")
+	}
+
+	return nil
+}
+
+// CreateRoute creates a route, which specifies the IP address
+// of the destination as well as a vNICset which provides
+// the next hop for routing packets.
+// adminDistance could be 0, 1 or 2 and 0 is the default.
+func (c Client) CreateRoute(
+	name string,
+	description string,
+	adminDistance int,
+	ipAddressPrefix string,
+	nextHopVnicSet string,
+	tags []string,
+) (resp response.Route, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty route name")
+	}
+
+	if err = validateRoute(
+		adminDistance, ipAddressPrefix, nextHopVnicSet,
+	); err != nil {
+		return resp, err
+	}
+
+	params := struct {
+		Name            string   `json:"name"`
+		Description     string   `json:"description,omitempty"`
+		AdminDistance   int      `json:"adminDistance"`
+		IpAddressPrefix string   `json:"ipAddressPrefix"`
+		NextHopVnicSet  string   `json:"nextHopVnicSet"`
+		Tags            []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description:     description,
+		AdminDistance:   adminDistance,
+		IpAddressPrefix: ipAddressPrefix,
+		NextHopVnicSet: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, nextHopVnicSet),
+		Tags: tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/route/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+	strip(&resp.NextHopVnicSet)
+
+	return resp, nil
+}
+
+// DeleteRoute deletes the specified route.
+func (c Client) DeleteRoute(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New("go-oracle-cloud: Empty route name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/route/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RouteDetails retrieves details of the specified route.
+func (c Client) RouteDetails(name string) (resp response.Route, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty route name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/route/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+	strip(&resp.NextHopVnicSet)
+
+	return resp, nil
+}
+
+// AllRoute retrieves details of all the routes
+// that are available in the account
+func (c Client) AllRoute() (resp response.AllRoute, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/route/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+		strip(&resp.Result[key].NextHopVnicSet)
+	}
+
+	return resp, nil
+}
+
+// UpdateRoute updates the description, tags, admin distance,
+// ip address prefix and the next hop vnic set of the specified route.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateRoute(
+	currentName string,
+	newName string,
+	description string,
+	adminDistance int,
+	ipAddressPrefix string,
+	nextHopVnicSet string,
+	tags []string,
+) (resp response.Route, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if currentName == "" {
+		return resp, errors.New("go-oracle-cloud: Empty route name")
+	}
+
+	if err = validateRoute(
+		adminDistance, ipAddressPrefix, nextHopVnicSet,
+	); err != nil {
+		return resp, err
+	}
+
+	if newName == "" {
+		newName = currentName
+	}
+
+	params := struct {
+		Name            string   `json:"name"`
+		Description     string   `json:"description,omitempty"`
+		AdminDistance   int      `json:"adminDistance"`
+		IpAddressPrefix string   `json:"ipAddressPrefix"`
+		NextHopVnicSet  string   `json:"nextHopVnicSet"`
+		Tags            []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, newName),
+		Description:     description,
+		AdminDistance:   adminDistance,
+		IpAddressPrefix: ipAddressPrefix,
+		NextHopVnicSet: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, nextHopVnicSet),
+		Tags: tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/route/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, currentName)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+	strip(&resp.NextHopVnicSet)
+
+	return resp, nil
+}
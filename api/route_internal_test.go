@@ -0,0 +1,46 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type routeTest struct{}
+
+var _ = gc.Suite(&routeTest{})
+
+func (r routeTest) TestValidateRoute(c *gc.C) {
+	for _, distance := range []int{0, 1, 2} {
+		c.Check(validateRoute(distance, "0.0.0.0/0", "web"), gc.IsNil)
+	}
+
+	for _, test := range []struct {
+		distance int
+		prefix   string
+		vnicset  string
+		err      string
+	}{{
+		distance: -1, prefix: "0.0.0.0/0", vnicset: "web",
+		err: "go-oracle-cloud: Invalid route admin distance -1, it should be 0, 1 or 2",
+	}, {
+		distance: 3, prefix: "0.0.0.0/0", vnicset: "web",
+		err: "go-oracle-cloud: Invalid route admin distance 3, it should be 0, 1 or 2",
+	}, {
+		prefix: "", vnicset: "web",
+		err: "go-oracle-cloud: Empty route ip address prefix",
+	}, {
+		prefix: "10.0.0.0/40", vnicset: "web",
+		err: `go-oracle-cloud: Invalid ip address prefix "10.0.0.0/40"`,
+	}, {
+		prefix: "10.0.0.1", vnicset: "web",
+		err: `go-oracle-cloud: Invalid ip address prefix "10.0.0.1"`,
+	}, {
+		prefix: "10.0.0.0/24", vnicset: "",
+		err: "go-oracle-cloud: Empty next hop vnic set name",
+	}} {
+		err := validateRoute(test.distance, test.prefix, test.vnicset)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
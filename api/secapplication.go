@@ -0,0 +1,378 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// protocols holds all the protocol names that
+// the oracle cloud api accepts for a security application
+var protocols = map[string]bool{
+	"tcp":    true,
+	"udp":    true,
+	"icmp":   true,
+	"igmp":   true,
+	"ipip":   true,
+	"rdp":    true,
+	"esp":    true,
+	"ah":     true,
+	"gre":    true,
+	"icmpv6": true,
+	"ospf":   true,
+	"pim":    true,
+	"sctp":   true,
+	"mplsip": true,
+	"all":    true,
+}
+
+// icmpTypes holds all the icmp types that a
+// security application can have
+var icmpTypes = map[string]bool{
+	"echo":        true,
+	"reply":       true,
+	"ttl":         true,
+	"traceroute":  true,
+	"unreachable": true,
+}
+
+// icmpCodes holds all the icmp codes that a
+// security application can have
+var icmpCodes = map[string]bool{
+	"network":  true,
+	"host":     true,
+	"protocol": true,
+	"port":     true,
+	"df":       true,
+	"admin":    true,
+}
+
+// SecApplicationParams type used to feed up
+// the CreateSecApplication function with params.
+type SecApplicationParams struct {
+	// Description is a description of the security application.
+	Description string `json:"description,omitempty"`
+
+	// Dport is the TCP or UDP destination port number.
+	// You can also specify a port range, such as 5900-5999 for TCP.
+	// If you specify tcp or udp as the protocol, then
+	// the dport parameter is required; otherwise, it is optional.
+	Dport string `json:"dport,omitempty"`
+
+	// Icmpcode is the ICMP code:
+	// network, host, protocol, port, df, admin.
+	// This can be used only with the icmp protocol.
+	Icmpcode string `json:"icmpcode,omitempty"`
+
+	// Icmptype is the ICMP type:
+	// echo, reply, ttl, traceroute, unreachable.
+	// This can be used only with the icmp protocol.
+	Icmptype string `json:"icmptype,omitempty"`
+
+	// Name is the name of the security application
+	Name string `json:"name"`
+
+	// Protocol is the protocol to use. The value that you specify
+	// can be either a text representation of a protocol
+	// (tcp, udp, icmp, igmp, ipip, rdp, esp, ah, gre, icmpv6,
+	// ospf, pim, sctp, mplsip, all) or any unsigned
+	// 8-bit assigned protocol number in the range 0-254.
+	Protocol string `json:"protocol"`
+}
+
+// validate checks if the combination of protocol, dport,
+// icmptype and icmpcode is one that the api accepts
+func (p SecApplicationParams) validate() error {
+	if p.Name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty security application name",
+		)
+	}
+
+	if p.Protocol == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty security application protocol",
+		)
+	}
+
+	protocol := strings.ToLower(p.Protocol)
+	if !protocols[protocol] {
+		n, err := strconv.ParseUint(protocol, 10, 8)
+		if err != nil || n > 254 {
+			return fmt.Errorf(
+				"go-oracle-cloud: Invalid security application protocol %q",
+				p.Protocol,
+			)
+		}
+	}
+
+	switch protocol {
+	case "tcp", "udp", "6", "17":
+		if p.Dport == "" {
+			return errors.New(
+				"go-oracle-cloud: Empty dport for tcp or udp security application",
+			)
+		}
+		if err := validateDport(p.Dport); err != nil {
+			return err
+		}
+	default:
+		if p.Dport != "" {
+			return fmt.Errorf(
+				"go-oracle-cloud: Dport can be used only with tcp or udp, not with %q",
+				p.Protocol,
+			)
+		}
+	}
+
+	if protocol != "icmp" && protocol != "1" {
+		if p.Icmptype != "" || p.Icmpcode != "" {
+			return errors.New(
+				"go-oracle-cloud: Icmptype and icmpcode can be used only with icmp",
+			)
+		}
+		return nil
+	}
+
+	if p.Icmptype != "" && !icmpTypes[p.Icmptype] {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid icmp type %q", p.Icmptype,
+		)
+	}
+
+	if p.Icmpcode != "" && !icmpCodes[p.Icmpcode] {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid icmp code %q", p.Icmpcode,
+		)
+	}
+
+	return nil
+}
+
+// validateDport checks if the dport is a valid port
+// or a valid port range like 5900-5999
+func validateDport(dport string) error {
+	ports := strings.Split(dport, "-")
+	if len(ports) > 2 {
+		return fmt.Errorf("go-oracle-cloud: Invalid dport range %q", dport)
+	}
+
+	var values [2]uint64
+	for i, port := range ports {
+		n, err := strconv.ParseUint(strings.TrimSpace(port), 10, 16)
+		if err != nil || n == 0 {
+			return fmt.Errorf("go-oracle-cloud: Invalid dport %q", dport)
+		}
+		values[i] = n
+	}
+
+	if len(ports) == 2 && values[0] > values[1] {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid dport range %q, low port is bigger than high port",
+			dport,
+		)
+	}
+
+	return nil
+}
+
+// CreateSecApplication creates a security application.
+// After creating security applications, you can reference
+// them in security rules.
+func (c Client) CreateSecApplication(
+	p SecApplicationParams,
+) (resp response.SecApplication, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if err = p.validate(); err != nil {
+		return resp, err
+	}
+
+	p.Protocol = strings.ToLower(p.Protocol)
+	p.Name = fmt.Sprintf("/Compute-%s/%s/%s",
+		c.identify, c.username, p.Name)
+
+	url := fmt.Sprintf("%s/secapplication/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		body:   &p,
+		verb:   "POST",
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// DeleteSecApplication deletes a security application.
+// No response is returned. You can't delete system-provided
+// security application that are available in the /oracle/public container.
+func (c Client) DeleteSecApplication(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty security application name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/secapplication/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SecApplicationDetails retrieves details about the specified security application.
+// You can use this request to verify whether CreateSecApplication
+// operation was completed successfully.
+func (c Client) SecApplicationDetails(
+	name string,
+) (resp response.SecApplication, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security application name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/secapplication/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllSecApplication retrieves details of the security applications
+// that are in the account
+func (c Client) AllSecApplication() (resp response.AllSecApplication, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/secapplication/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
+
+// DefaultSecApplicationDetails retrieves details about one of the
+// predefined security applications that are available in the
+// /oracle/public container, like ssh, http or rdp.
+func (c Client) DefaultSecApplicationDetails(
+	name string,
+) (resp response.SecApplication, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security application name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/secapplication/oracle/public/%s",
+		c.endpoint, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllDefaultSecApplication retrieves details of all the predefined
+// security applications that are available in the /oracle/public container.
+func (c Client) AllDefaultSecApplication() (resp response.AllSecApplication, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/secapplication/oracle/public/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
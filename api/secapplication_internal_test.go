@@ -0,0 +1,34 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type secApplicationTest struct{}
+
+var _ = gc.Suite(&secApplicationTest{})
+
+func (s secApplicationTest) TestValidateDport(c *gc.C) {
+	for _, dport := range []string{"22", "1", "65535", "8000-8080", "80-80", " 80 - 90 "} {
+		c.Check(validateDport(dport), gc.IsNil, gc.Commentf("dport %q", dport))
+	}
+
+	for _, test := range []struct {
+		dport string
+		err   string
+	}{
+		{"", `go-oracle-cloud: Invalid dport ""`},
+		{"0", `go-oracle-cloud: Invalid dport "0"`},
+		{"65536", `go-oracle-cloud: Invalid dport "65536"`},
+		{"http", `go-oracle-cloud: Invalid dport "http"`},
+		{"-80", `go-oracle-cloud: Invalid dport "-80"`},
+		{"80-", `go-oracle-cloud: Invalid dport "80-"`},
+		{"1-2-3", `go-oracle-cloud: Invalid dport range "1-2-3"`},
+		{"90-80", `go-oracle-cloud: Invalid dport range "90-80", low port is bigger than high port`},
+	} {
+		c.Check(validateDport(test.dport), gc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// CreateSecAssociation creates a security association between
+// the specified security list and the specified instance vcable.
+// This adds the instance to the security list.
+// name is optional, if it's empty the api will generate one.
+// vcable is the vcable id of the instance, if you only know
+// the name of the instance use InstanceVcable to find it.
+func (c Client) CreateSecAssociation(
+	name string,
+	seclist string,
+	vcable string,
+) (resp response.SecAssociation, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if seclist == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty secure list name",
+		)
+	}
+
+	if vcable == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty vcable name",
+		)
+	}
+
+	params := struct {
+		Name    string `json:"name,omitempty"`
+		Seclist string `json:"seclist"`
+		Vcable  string `json:"vcable"`
+	}{
+		Seclist: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, seclist),
+		Vcable: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, vcable),
+	}
+
+	if name != "" {
+		params.Name = fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name)
+	}
+
+	url := fmt.Sprintf("%s/secassociation/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		body:   &params,
+		verb:   "POST",
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+	strip(&resp.Seclist)
+	strip(&resp.Vcable)
+
+	return resp, nil
+}
+
+// DeleteSecAssociation deletes the specified security association.
+// This removes the instance from the security list.
+func (c Client) DeleteSecAssociation(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty security association name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/secassociation/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SecAssociationDetails retrieves details of the specified security association.
+func (c Client) SecAssociationDetails(
+	name string,
+) (resp response.SecAssociation, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security association name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/secassociation/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+	strip(&resp.Seclist)
+	strip(&resp.Vcable)
+
+	return resp, nil
+}
+
+// AllSecAssociation retrieves details of the security associations
+// that are in the account
+func (c Client) AllSecAssociation() (resp response.AllSecAssociation, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/secassociation/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+		strip(&resp.Result[key].Seclist)
+		strip(&resp.Result[key].Vcable)
+	}
+
+	return resp, nil
+}
+
+// InstanceVcable retrieves the vcable id of the eth0 interface of
+// the instance with the given name. The name is in the form of
+// dev-name/uuid. The vcable could be used to create
+// security associations or ip associations.
+func (c Client) InstanceVcable(instanceName string) (vcable string, err error) {
+	if instanceName == "" {
+		return "", errors.New("go-oracle-cloud: Empty instance name")
+	}
+
+	instance, err := c.InstanceDetails(instanceName)
+	if err != nil {
+		return "", err
+	}
+
+	vcable = instance.Attributes.Network.Vcable_eth0.Id
+	if vcable == "" {
+		return "", fmt.Errorf(
+			"go-oracle-cloud: Instance %s has no vcable on eth0", instanceName,
+		)
+	}
+
+	strip(&vcable)
+
+	return vcable, nil
+}
@@ -12,8 +12,8 @@ import (
 )
 
 // CreatesSecList a security list. After creating security
-// lists, you can add instances to them by using the HTTP request,
-// CreateSecAssociation (Create a Security Association).
+// lists, you can add instances to them by using the
+// CreateSecAssociation method.
 func (c Client) CreateSecList(
 	description string,
 	name string,
@@ -0,0 +1,293 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// validateSecurityProtocol checks if the ip protocol is a known
+// protocol name or number and that the port sets are valid
+// and used only with tcp or udp
+func validateSecurityProtocol(
+	ipProtocol string,
+	srcPortSet []string,
+	dstPortSet []string,
+) error {
+	protocol := strings.ToLower(ipProtocol)
+	if !protocols[protocol] {
+		n, err := strconv.ParseUint(protocol, 10, 8)
+		if err != nil || n > 254 {
+			return fmt.Errorf(
+				"go-oracle-cloud: Invalid security protocol ip protocol %q",
+				ipProtocol,
+			)
+		}
+	}
+
+	switch protocol {
+	case "tcp", "udp", "6", "17":
+	default:
+		if len(srcPortSet) != 0 || len(dstPortSet) != 0 {
+			return fmt.Errorf(
+				"go-oracle-cloud: Port sets can be used only with tcp or udp, not with %q",
+				ipProtocol,
+			)
+		}
+	}
+
+	for _, port := range srcPortSet {
+		if err := validateDport(port); err != nil {
+			return err
+		}
+	}
+
+	for _, port := range dstPortSet {
+		if err := validateDport(port); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateSecurityProtocol creates a security protocol for ip networks.
+// A security protocol allows you to specify a transport protocol and the
+// source and destination ports to be used with the specified protocol.
+// ipProtocol could be empty and in this case it defaults to all.
+// If you don't specify the srcPortSet or the dstPortSet,
+// traffic is allowed on all ports.
+func (c Client) CreateSecurityProtocol(
+	name string,
+	description string,
+	ipProtocol string,
+	srcPortSet []string,
+	dstPortSet []string,
+	tags []string,
+) (resp response.SecurityProtocol, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security protocol name",
+		)
+	}
+
+	if ipProtocol == "" {
+		ipProtocol = "all"
+	}
+
+	if err = validateSecurityProtocol(
+		ipProtocol, srcPortSet, dstPortSet,
+	); err != nil {
+		return resp, err
+	}
+
+	params := struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description,omitempty"`
+		IpProtocol  string   `json:"ipProtocol"`
+		SrcPortSet  []string `json:"srcPortSet,omitempty"`
+		DstPortSet  []string `json:"dstPortSet,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description: description,
+		IpProtocol:  strings.ToLower(ipProtocol),
+		SrcPortSet:  srcPortSet,
+		DstPortSet:  dstPortSet,
+		Tags:        tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secprotocol/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// DeleteSecurityProtocol deletes the specified security protocol.
+// Ensure that the protocol is not referenced in any security rule.
+func (c Client) DeleteSecurityProtocol(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New(
+			"go-oracle-cloud: Empty security protocol name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secprotocol/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SecurityProtocolDetails retrieves details of the specified security protocol.
+func (c Client) SecurityProtocolDetails(
+	name string,
+) (resp response.SecurityProtocol, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security protocol name",
+		)
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secprotocol/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllSecurityProtocol retrieves details of all the security
+// protocols that are available in the account
+func (c Client) AllSecurityProtocol() (resp response.AllSecurityProtocol, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secprotocol/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
+
+// UpdateSecurityProtocol updates the specified security protocol.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateSecurityProtocol(
+	currentName string,
+	newName string,
+	description string,
+	ipProtocol string,
+	srcPortSet []string,
+	dstPortSet []string,
+	tags []string,
+) (resp response.SecurityProtocol, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if currentName == "" {
+		return resp, errors.New(
+			"go-oracle-cloud: Empty security protocol name",
+		)
+	}
+
+	if ipProtocol == "" {
+		ipProtocol = "all"
+	}
+
+	if err = validateSecurityProtocol(
+		ipProtocol, srcPortSet, dstPortSet,
+	); err != nil {
+		return resp, err
+	}
+
+	if newName == "" {
+		newName = currentName
+	}
+
+	params := struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description,omitempty"`
+		IpProtocol  string   `json:"ipProtocol"`
+		SrcPortSet  []string `json:"srcPortSet,omitempty"`
+		DstPortSet  []string `json:"dstPortSet,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, newName),
+		Description: description,
+		IpProtocol:  strings.ToLower(ipProtocol),
+		SrcPortSet:  srcPortSet,
+		DstPortSet:  dstPortSet,
+		Tags:        tags,
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secprotocol/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, currentName)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type securityProtocolTest struct{}
+
+var _ = gc.Suite(&securityProtocolTest{})
+
+func (s securityProtocolTest) TestValidateSecurityProtocol(c *gc.C) {
+	for _, test := range []struct {
+		protocol string
+		src, dst []string
+		err      string
+	}{
+		{protocol: "all"},
+		{protocol: "TCP", dst: []string{"80", "8000-8080"}},
+		{protocol: "17", src: []string{"53"}},
+		{protocol: "icmp"},
+		{protocol: "254"},
+		{protocol: "http", err: `go-oracle-cloud: Invalid security protocol ip protocol "http"`},
+		{protocol: "255", err: `go-oracle-cloud: Invalid security protocol ip protocol "255"`},
+		{protocol: "-1", err: `go-oracle-cloud: Invalid security protocol ip protocol "-1"`},
+		{
+			protocol: "icmp", dst: []string{"80"},
+			err: `go-oracle-cloud: Port sets can be used only with tcp or udp, not with "icmp"`,
+		}, {
+			protocol: "all", src: []string{"22"},
+			err: `go-oracle-cloud: Port sets can be used only with tcp or udp, not with "all"`,
+		}, {
+			protocol: "tcp", src: []string{"0"},
+			err: `go-oracle-cloud: Invalid dport "0"`,
+		}, {
+			protocol: "udp", dst: []string{"90-80"},
+			err: `go-oracle-cloud: Invalid dport range "90-80", low port is bigger than high port`,
+		},
+	} {
+		err := validateSecurityProtocol(test.protocol, test.src, test.dst)
+		if test.err == "" {
+			c.Check(err, gc.IsNil, gc.Commentf("protocol %q", test.protocol))
+			continue
+		}
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// SecurityRuleParams type used to feed up
+// the CreateSecurityRule and UpdateSecurityRule functions with params.
+type SecurityRuleParams struct {
+	// Name is the name of the security rule
+	Name string
+
+	// Acl is the name of the acl that contains this rule.
+	// If it's empty the rule is added to the default acl.
+	Acl string
+
+	// Description is the description of the security rule.
+	Description string
+
+	// FlowDirection is the direction of the flow, ingress or egress.
+	FlowDirection response.FlowDirection
+
+	// SrcVnicSet is the name of virtual NIC set containing
+	// the packet's source virtual NIC.
+	SrcVnicSet string
+
+	// DstVnicSet is the name of virtual NIC set containing
+	// the packet's destination virtual NIC.
+	DstVnicSet string
+
+	// SrcIpAddressPrefixSets list of IP address prefix set names
+	// to match the packet's source IP address.
+	SrcIpAddressPrefixSets []string
+
+	// DstIpAddressPrefixSets list of IP address prefix set names
+	// to match the packet's destination IP address.
+	DstIpAddressPrefixSets []string
+
+	// SecProtocols list of security protocol names to match
+	// the packet's protocol and port.
+	SecProtocols []string
+
+	// EnabledFlag toggles the rule on or off.
+	EnabledFlag bool
+
+	// Tags associated with the security rule.
+	Tags []string
+}
+
+// validate checks if the security rule params are ones
+// that the api accepts
+func (p SecurityRuleParams) validate() error {
+	if p.Name == "" {
+		return errors.New("go-oracle-cloud: Empty security rule name")
+	}
+
+	switch p.FlowDirection {
+	case response.Ingress, response.Egress:
+	default:
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid security rule flow direction %q",
+			p.FlowDirection,
+		)
+	}
+
+	return nil
+}
+
+// securityRuleRequest is the body of the security rule requests
+type securityRuleRequest struct {
+	Name                   string                 `json:"name"`
+	Acl                    string                 `json:"acl,omitempty"`
+	Description            string                 `json:"description,omitempty"`
+	FlowDirection          response.FlowDirection `json:"flowDirection"`
+	SrcVnicSet             string                 `json:"srcVnicSet,omitempty"`
+	DstVnicSet             string                 `json:"dstVnicSet,omitempty"`
+	SrcIpAddressPrefixSets []string               `json:"srcIpAddressPrefixSets,omitempty"`
+	DstIpAddressPrefixSets []string               `json:"dstIpAddressPrefixSets,omitempty"`
+	SecProtocols           []string               `json:"secProtocols,omitempty"`
+	EnabledFlag            bool                   `json:"enabledFlag"`
+	Tags                   []string               `json:"tags,omitempty"`
+}
+
+// body constructs the oracle cloud complaint body
+// for the security rule requests
+func (p SecurityRuleParams) body(identify, username string) securityRuleRequest {
+	name := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return fmt.Sprintf("/Compute-%s/%s/%s", identify, username, s)
+	}
+
+	names := func(s []string) []string {
+		if s == nil {
+			return nil
+		}
+		list := make([]string, len(s))
+		for key := range s {
+			list[key] = name(s[key])
+		}
+		return list
+	}
+
+	return securityRuleRequest{
+		Name:                   name(p.Name),
+		Acl:                    name(p.Acl),
+		Description:            p.Description,
+		FlowDirection:          p.FlowDirection,
+		SrcVnicSet:             name(p.SrcVnicSet),
+		DstVnicSet:             name(p.DstVnicSet),
+		SrcIpAddressPrefixSets: names(p.SrcIpAddressPrefixSets),
+		DstIpAddressPrefixSets: names(p.DstIpAddressPrefixSets),
+		SecProtocols:           names(p.SecProtocols),
+		EnabledFlag:            p.EnabledFlag,
+		Tags:                   p.Tags,
+	}
+}
+
+// stripSecurityRule strips all the names
+// that the security rule references
+func stripSecurityRule(rule *response.SecurityRule) {
+	strip(&rule.Name)
+	strip(&rule.Acl)
+	strip(&rule.SrcVnicSet)
+	strip(&rule.DstVnicSet)
+	for key := range rule.SrcIpAddressPrefixSets {
+		strip(&rule.SrcIpAddressPrefixSets[key])
+	}
+	for key := range rule.DstIpAddressPrefixSets {
+		strip(&rule.DstIpAddressPrefixSets[key])
+	}
+	for key := range rule.SecProtocols {
+		strip(&rule.SecProtocols[key])
+	}
+}
+
+// CreateSecurityRule creates a security rule for ip networks.
+// A security rule permits traffic from a specified source or to a
+// specified destination. You must specify the direction of a
+// security rule, either ingress or egress. In addition, you can specify
+// the source or destination of permitted traffic, and the security
+// protocol and port used to send or receive packets.
+func (c Client) CreateSecurityRule(
+	p SecurityRuleParams,
+) (resp response.SecurityRule, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if err = p.validate(); err != nil {
+		return resp, err
+	}
+
+	params := p.body(c.identify, c.username)
+
+	url := fmt.Sprintf("%s/network/v1/secrule/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripSecurityRule(&resp)
+
+	return resp, nil
+}
+
+// DeleteSecurityRule deletes the specified security rule.
+func (c Client) DeleteSecurityRule(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New("go-oracle-cloud: Empty security rule name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secrule/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SecurityRuleDetails retrieves details of the specified security rule.
+func (c Client) SecurityRuleDetails(
+	name string,
+) (resp response.SecurityRule, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty security rule name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secrule/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripSecurityRule(&resp)
+
+	return resp, nil
+}
+
+// AllSecurityRule retrieves details of all the security
+// rules of ip networks that are available in the account
+func (c Client) AllSecurityRule() (resp response.AllSecurityRule, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secrule/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		stripSecurityRule(&resp.Result[key])
+	}
+
+	return resp, nil
+}
+
+// UpdateSecurityRule updates the specified security rule.
+// The whole rule is replaced with the params given so all the
+// fields of the rule must be provided.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateSecurityRule(
+	p SecurityRuleParams,
+	newName string,
+) (resp response.SecurityRule, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if err = p.validate(); err != nil {
+		return resp, err
+	}
+
+	url := fmt.Sprintf("%s/network/v1/secrule/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, p.Name)
+
+	if newName != "" {
+		p.Name = newName
+	}
+
+	params := p.body(c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripSecurityRule(&resp)
+
+	return resp, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// ShapeRequirements are the minimum resources that
+// a shape must provide for an instance
+type ShapeRequirements struct {
+	// Cpus is the minimum number of CPUs
+	Cpus float64
+
+	// Ram is the minimum memory in megabytes
+	Ram uint64
+
+	// Gpus is the minimum number of gpu devices
+	Gpus uint64
+
+	// SsdDataSize is the minimum size of the local
+	// SSD data disk in bytes
+	SsdDataSize uint64
+
+	// Image if it's not nil the shape must also be supported by
+	// the image list entry, see ShapeCatalogue.SupportsImage
+	Image *response.ImageListEntry
+}
+
+// ShapeCatalogue holds all the shapes of a site ordered
+// from the smallest to the biggest one, so launch code
+// could pick shapes from requirements instead of names.
+type ShapeCatalogue struct {
+	shapes []response.Shape
+}
+
+// NewShapeCatalogue returns a shape catalogue with the given shapes
+func NewShapeCatalogue(shapes []response.Shape) ShapeCatalogue {
+	s := ShapeCatalogue{shapes: make([]response.Shape, len(shapes))}
+	copy(s.shapes, shapes)
+
+	sort.Sort(byShapeSize(s.shapes))
+
+	return s
+}
+
+// byShapeSize orders the shapes by cpus, ram, gpus,
+// ssd data size and then by name
+type byShapeSize []response.Shape
+
+func (s byShapeSize) Len() int      { return len(s) }
+func (s byShapeSize) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byShapeSize) Less(i, j int) bool {
+	a, b := s[i], s[j]
+	switch {
+	case a.Cpus != b.Cpus:
+		return a.Cpus < b.Cpus
+	case a.Ram != b.Ram:
+		return a.Ram < b.Ram
+	case a.Gpus != b.Gpus:
+		return a.Gpus < b.Gpus
+	case a.Ssd_data_size != b.Ssd_data_size:
+		return a.Ssd_data_size < b.Ssd_data_size
+	default:
+		return a.Name < b.Name
+	}
+}
+
+// ShapeCatalogue returns the shape catalogue of all the
+// shapes that are available in the site
+func (c Client) ShapeCatalogue() (ShapeCatalogue, error) {
+	shapes, err := c.AllShapeDetails()
+	if err != nil {
+		return ShapeCatalogue{}, err
+	}
+
+	return NewShapeCatalogue(shapes.Result), nil
+}
+
+// Shapes returns all the shapes of the catalogue
+// ordered from the smallest to the biggest one
+func (s ShapeCatalogue) Shapes() []response.Shape {
+	shapes := make([]response.Shape, len(s.shapes))
+	copy(shapes, s.shapes)
+	return shapes
+}
+
+// Lookup returns the shape with the given name
+func (s ShapeCatalogue) Lookup(name string) (response.Shape, bool) {
+	for _, shape := range s.shapes {
+		if shape.Name == name {
+			return shape, true
+		}
+	}
+
+	return response.Shape{}, false
+}
+
+// Filter returns all the shapes that satisfy the requirements
+// ordered from the smallest to the biggest one
+func (s ShapeCatalogue) Filter(r ShapeRequirements) []response.Shape {
+	var shapes []response.Shape
+	for _, shape := range s.shapes {
+		if shape.Cpus < r.Cpus ||
+			shape.Ram < r.Ram ||
+			shape.Gpus < r.Gpus ||
+			shape.Ssd_data_size < r.SsdDataSize {
+			continue
+		}
+
+		if r.Image != nil && s.SupportsImage(shape, *r.Image) != nil {
+			continue
+		}
+
+		shapes = append(shapes, shape)
+	}
+
+	return shapes
+}
+
+// Smallest returns the smallest shape that satisfies the requirements
+func (s ShapeCatalogue) Smallest(r ShapeRequirements) (response.Shape, error) {
+	shapes := s.Filter(r)
+	if len(shapes) == 0 {
+		return response.Shape{}, fmt.Errorf(
+			"go-oracle-cloud: No shape with at least %v cpus, %d MB ram, %d gpus and %d bytes ssd",
+			r.Cpus, r.Ram, r.Gpus, r.SsdDataSize,
+		)
+	}
+
+	return shapes[0], nil
+}
+
+// SupportsImage checks if the shape could be used to launch
+// an instance from the image list entry. The entry could
+// restrict the shapes with its supportedShapes attribute,
+// a comma separated list of shape names, and shapes with a
+// local root disk must have a root disk at least as big as the
+// minimumdisksize attribute of the entry, in gigabytes.
+func (s ShapeCatalogue) SupportsImage(
+	shape response.Shape,
+	entry response.ImageListEntry,
+) error {
+
+	if supported := entry.Attributes.SupportedShapes; supported != "" {
+		found := false
+		for _, name := range strings.Split(supported, ",") {
+			if strings.TrimSpace(name) == shape.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf(
+				"go-oracle-cloud: Shape %s is not supported by the image, supported shapes are %s",
+				shape.Name, supported,
+			)
+		}
+	}
+
+	minimum := strings.TrimSpace(entry.Attributes.MinimumDiskSize)
+	if minimum == "" || shape.Root_disk_size == 0 {
+		return nil
+	}
+
+	size, err := strconv.ParseUint(minimum, 10, 64)
+	if err != nil {
+		return fmt.Errorf(
+			"go-oracle-cloud: Invalid minimum disk size of the image %s",
+			minimum,
+		)
+	}
+
+	if shape.Root_disk_size < size<<30 {
+		return fmt.Errorf(
+			"go-oracle-cloud: Shape %s root disk of %d bytes is smaller than the %d GB the image needs",
+			shape.Name, shape.Root_disk_size, size,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type shapeCatalogueTest struct{}
+
+var _ = gc.Suite(&shapeCatalogueTest{})
+
+var testShapes = []response.Shape{
+	{Name: "oc5", Cpus: 8, Ram: 30720},
+	{Name: "oc3", Cpus: 2, Ram: 7680},
+	{Name: "ocio1m", Cpus: 2, Ram: 15360, Ssd_data_size: 400 << 30, Root_disk_size: 20 << 30},
+	{Name: "oc4", Cpus: 4, Ram: 15360},
+	{Name: "ocsg1-k80", Cpus: 16, Ram: 122880, Gpus: 1},
+}
+
+func (s shapeCatalogueTest) TestLookup(c *gc.C) {
+	catalogue := api.NewShapeCatalogue(testShapes)
+
+	shape, ok := catalogue.Lookup("oc4")
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(shape.Cpus, gc.Equals, float64(4))
+
+	_, ok = catalogue.Lookup("oc99")
+	c.Assert(ok, gc.Equals, false)
+}
+
+func (s shapeCatalogueTest) TestSmallest(c *gc.C) {
+	catalogue := api.NewShapeCatalogue(testShapes)
+
+	tests := []struct {
+		requirements api.ShapeRequirements
+		name         string
+	}{
+		{api.ShapeRequirements{}, "oc3"},
+		{api.ShapeRequirements{Ram: 10000}, "ocio1m"},
+		{api.ShapeRequirements{Cpus: 3}, "oc4"},
+		{api.ShapeRequirements{Gpus: 1}, "ocsg1-k80"},
+		{api.ShapeRequirements{SsdDataSize: 1}, "ocio1m"},
+	}
+
+	for _, test := range tests {
+		shape, err := catalogue.Smallest(test.requirements)
+		c.Assert(err, gc.IsNil)
+		c.Assert(shape.Name, gc.Equals, test.name)
+	}
+
+	_, err := catalogue.Smallest(api.ShapeRequirements{Cpus: 64})
+	c.Assert(err, gc.NotNil)
+}
+
+func (s shapeCatalogueTest) TestImage(c *gc.C) {
+	catalogue := api.NewShapeCatalogue(testShapes)
+
+	entry := response.ImageListEntry{
+		Attributes: response.AttributesEntry{
+			SupportedShapes: "oc4, oc5,ocio1m",
+			MinimumDiskSize: "30",
+		},
+	}
+
+	// ocio1m has a local root disk of 20 GB
+	shape, err := catalogue.Smallest(api.ShapeRequirements{Image: &entry})
+	c.Assert(err, gc.IsNil)
+	c.Assert(shape.Name, gc.Equals, "oc4")
+
+	oc3, _ := catalogue.Lookup("oc3")
+	c.Assert(catalogue.SupportsImage(oc3, entry), gc.NotNil)
+
+	ocio1m, _ := catalogue.Lookup("ocio1m")
+	c.Assert(catalogue.SupportsImage(ocio1m, entry), gc.NotNil)
+
+	entry.Attributes.MinimumDiskSize = "10"
+	c.Assert(catalogue.SupportsImage(ocio1m, entry), gc.IsNil)
+}
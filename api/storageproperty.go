@@ -0,0 +1,104 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// AllStorageProperty retrieves details of all the storage properties
+// that are available in the site, like /oracle/public/storage/default,
+// /oracle/public/storage/latency or /oracle/public/storage/ssd/gpl.
+// The names of the storage properties are not stripped because
+// they are used as they are when creating storage volumes.
+func (c Client) AllStorageProperty() (resp response.AllStorageProperty, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/property/storage/oracle/public/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// StoragePropertyDetails retrieves details of the specified storage property.
+// The name could be the full name, /oracle/public/storage/default,
+// or only the part after /oracle/public/storage/, default.
+func (c Client) StoragePropertyDetails(
+	name string,
+) (resp response.StorageProperty, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty storage property name")
+	}
+
+	if !strings.HasPrefix(name, "/") {
+		name = "/oracle/public/storage/" + name
+	}
+
+	url := fmt.Sprintf("%s/property/storage%s", c.endpoint, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// checkStorageProperties verifies that the properties
+// are in the storage properties catalogue of the site
+func (c Client) checkStorageProperties(properties []string) error {
+	if len(properties) != 1 {
+		return errors.New(
+			"go-oracle-cloud: A storage volume needs exactly one storage property",
+		)
+	}
+
+	catalogue, err := c.AllStorageProperty()
+	if err != nil {
+		return err
+	}
+
+	for _, property := range catalogue.Result {
+		if property.Name == properties[0] {
+			return nil
+		}
+	}
+
+	names := make([]string, 0, len(catalogue.Result))
+	for _, property := range catalogue.Result {
+		names = append(names, property.Name)
+	}
+
+	return fmt.Errorf(
+		"go-oracle-cloud: Storage property %q is not available, use one of %s",
+		properties[0], strings.Join(names, ", "),
+	)
+}
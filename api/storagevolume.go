@@ -0,0 +1,226 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// StorageVolumeParams used to feed the CreateStorageVolume function
+type StorageVolumeParams struct {
+	// Name is the name of the storage volume
+	Name string
+
+	// Size is the size of the volume in bytes, or with
+	// one of the suffixes B, K, M, G or T, like 10G
+	Size string
+
+	// Properties holds the storage property of the volume,
+	// like /oracle/public/storage/default. Exactly one property
+	// must be given and it must be one of the properties
+	// returned by AllStorageProperty.
+	Properties []string
+
+	// Description is the description of the storage volume
+	Description string
+
+	// Tags associated with the volume
+	Tags []string
+
+	// Bootable is true if the volume will be used as a boot disk
+	Bootable bool
+
+	// Imagelist is the imagelist of the bootable volume
+	Imagelist string
+
+	// Imagelist_entry is the imagelist entry of the bootable volume
+	Imagelist_entry int
+}
+
+// validate checks if the storage volume params are ones that the api accepts
+func (p StorageVolumeParams) validate() error {
+	if p.Name == "" {
+		return errors.New("go-oracle-cloud: Empty storage volume name")
+	}
+
+	if p.Size == "" {
+		return errors.New("go-oracle-cloud: Empty storage volume size")
+	}
+
+	if p.Bootable && p.Imagelist == "" {
+		return errors.New(
+			"go-oracle-cloud: A bootable storage volume needs an image list",
+		)
+	}
+
+	if !p.Bootable && p.Imagelist != "" {
+		return errors.New(
+			"go-oracle-cloud: Image list requires a bootable storage volume",
+		)
+	}
+
+	return nil
+}
+
+// CreateStorageVolume creates a storage volume with the given params.
+// Before posting, the storage property of the volume is checked
+// against the storage properties that are available in the site.
+func (c Client) CreateStorageVolume(
+	p StorageVolumeParams,
+) (resp response.StorageVolume, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if err = p.validate(); err != nil {
+		return resp, err
+	}
+
+	if err = c.checkStorageProperties(p.Properties); err != nil {
+		return resp, err
+	}
+
+	params := struct {
+		Name            string   `json:"name"`
+		Size            string   `json:"size"`
+		Properties      []string `json:"properties"`
+		Description     string   `json:"description,omitempty"`
+		Tags            []string `json:"tags,omitempty"`
+		Bootable        bool     `json:"bootable"`
+		Imagelist       string   `json:"imagelist,omitempty"`
+		Imagelist_entry int      `json:"imagelist_entry,omitempty"`
+	}{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, p.Name),
+		Size:            p.Size,
+		Properties:      p.Properties,
+		Description:     p.Description,
+		Tags:            p.Tags,
+		Bootable:        p.Bootable,
+		Imagelist:       p.Imagelist,
+		Imagelist_entry: p.Imagelist_entry,
+	}
+
+	c.qualify(&params.Imagelist)
+
+	url := fmt.Sprintf("%s/storage/volume/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// DeleteStorageVolume deletes the specified storage volume.
+// Ensure that the volume is not attached to any instance.
+func (c Client) DeleteStorageVolume(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New("go-oracle-cloud: Empty storage volume name")
+	}
+
+	url := fmt.Sprintf("%s/storage/volume/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StorageVolumeDetails retrieves details of the specified storage volume.
+func (c Client) StorageVolumeDetails(
+	name string,
+) (resp response.StorageVolume, err error) {
+	return c.storageVolumeDetails(nil, name)
+}
+
+// storageVolumeDetails retrieves the details of the storage volume
+// within the ctx
+func (c Client) storageVolumeDetails(
+	ctx context.Context,
+	name string,
+) (resp response.StorageVolume, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty storage volume name")
+	}
+
+	url := fmt.Sprintf("%s/storage/volume/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		ctx:    ctx,
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	strip(&resp.Name)
+
+	return resp, nil
+}
+
+// AllStorageVolume retrieves details of all the storage
+// volumes that are available in the account
+func (c Client) AllStorageVolume() (resp response.AllStorageVolume, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/storage/volume/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		strip(&resp.Result[key].Name)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	gc "gopkg.in/check.v1"
+)
+
+type storageVolumeTest struct{}
+
+var _ = gc.Suite(&storageVolumeTest{})
+
+func (s storageVolumeTest) TestCreateBody(c *gc.C) {
+	server, cli, recorder := newRecordingClient(c)
+	defer server.Close()
+
+	_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "volume1",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	})
+	c.Assert(err, gc.IsNil)
+
+	body := recorder.body("POST", "/storage/volume/")
+	c.Assert(body, gc.DeepEquals, map[string]interface{}{
+		"name":       "/Compute-myIdentify/oracleusername@oracle.com/volume1",
+		"size":       "10G",
+		"properties": []interface{}{"/oracle/public/storage/default"},
+		"bootable":   false,
+	})
+}
+
+func (s storageVolumeTest) TestStorageProperties(c *gc.C) {
+	server, cli := newFakeClient(c)
+	defer server.Close()
+
+	for _, test := range []struct {
+		properties []string
+		err        string
+	}{{
+		properties: nil,
+		err:        "go-oracle-cloud: A storage volume needs exactly one storage property",
+	}, {
+		properties: []string{
+			"/oracle/public/storage/default",
+			"/oracle/public/storage/latency",
+		},
+		err: "go-oracle-cloud: A storage volume needs exactly one storage property",
+	}, {
+		properties: []string{"/oracle/public/storage/fast"},
+		err:        `go-oracle-cloud: Storage property "/oracle/public/storage/fast" is not available, use one of .*/oracle/public/storage/default.*`,
+	}, {
+		properties: []string{"/oracle/public/storage/latency"},
+	}} {
+		_, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+			Name:       "volume",
+			Size:       "10G",
+			Properties: test.properties,
+		})
+		if test.err != "" {
+			c.Assert(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+	}
+
+	c.Assert(server.Names("storage/volume"), gc.HasLen, 1)
+}
@@ -0,0 +1,191 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+const (
+	// MaxUserdataSize is the maximum size in bytes of the json
+	// encoded userdata that the api accepts for an instance
+	MaxUserdataSize = 16 * 1024
+
+	// CloudConfigKey is the userdata key under which
+	// the cloud-config document is embedded
+	CloudConfigKey = "user_data"
+
+	// PreBootstrapKey is the userdata key under which the
+	// opc-init pre-bootstrap script is embedded
+	PreBootstrapKey = "pre-bootstrap"
+)
+
+// PreBootstrap is the opc-init pre-bootstrap script that
+// runs on Oracle-provided images before the instance boots.
+// The script could be given inline or as an url that
+// the instance will download it from.
+type PreBootstrap struct {
+	// Script are the lines of the script that will run
+	Script []string
+
+	// ScriptURL is the url from where the script will be downloaded
+	ScriptURL string
+
+	// Failonerror if it's true the bootstrap stops
+	// if the script fails
+	Failonerror bool
+}
+
+// Userdata builds up the userdata attributes of an instance.
+// The userdata is a set of key value pairs that is available
+// inside the instance at http://192.0.0.192/latest/user-data.
+type Userdata struct {
+	values map[string]interface{}
+}
+
+// NewUserdata returns a new empty userdata
+func NewUserdata() *Userdata {
+	return &Userdata{values: make(map[string]interface{})}
+}
+
+// UserdataFromAttributes returns the userdata from
+// the attributes of an existing instance
+func UserdataFromAttributes(attributes response.Attributes) *Userdata {
+	u := NewUserdata()
+	for key, value := range attributes.Userdata {
+		u.values[key] = value
+	}
+	return u
+}
+
+// Set sets the value of the userdata key
+func (u *Userdata) Set(key string, value interface{}) *Userdata {
+	u.values[key] = value
+	return u
+}
+
+// Get returns the value of the userdata key
+func (u *Userdata) Get(key string) (value interface{}, ok bool) {
+	value, ok = u.values[key]
+	return value, ok
+}
+
+// CloudConfig embeds the cloud-config document in the userdata
+func (u *Userdata) CloudConfig(document string) *Userdata {
+	return u.Set(CloudConfigKey, document)
+}
+
+// CloudConfigDocument returns the cloud-config document
+// embedded in the userdata
+func (u *Userdata) CloudConfigDocument() (document string, ok bool) {
+	document, ok = u.values[CloudConfigKey].(string)
+	return document, ok
+}
+
+// PreBootstrap embeds the opc-init pre-bootstrap script in the userdata
+func (u *Userdata) PreBootstrap(script PreBootstrap) *Userdata {
+	value := map[string]interface{}{
+		"failonerror": script.Failonerror,
+	}
+
+	if script.Script != nil {
+		lines := make([]interface{}, 0, len(script.Script))
+		for _, line := range script.Script {
+			lines = append(lines, line)
+		}
+		value["script"] = lines
+	}
+
+	if script.ScriptURL != "" {
+		value["scriptURL"] = script.ScriptURL
+	}
+
+	return u.Set(PreBootstrapKey, value)
+}
+
+// PreBootstrapScript returns the opc-init pre-bootstrap
+// script embedded in the userdata
+func (u *Userdata) PreBootstrapScript() (script PreBootstrap, ok bool) {
+	value, ok := u.values[PreBootstrapKey].(map[string]interface{})
+	if !ok {
+		return script, false
+	}
+
+	script.Failonerror, _ = value["failonerror"].(bool)
+	script.ScriptURL, _ = value["scriptURL"].(string)
+
+	lines, _ := value["script"].([]interface{})
+	for _, line := range lines {
+		if s, ok := line.(string); ok {
+			script.Script = append(script.Script, s)
+		}
+	}
+
+	return script, true
+}
+
+// validate checks if the userdata is one that the api accepts
+func (u *Userdata) validate() error {
+	if document, ok := u.values[CloudConfigKey]; ok {
+		s, ok := document.(string)
+		if !ok || !strings.HasPrefix(s, "#cloud-config") {
+			return errors.New(
+				"go-oracle-cloud: The cloud-config document should start with #cloud-config",
+			)
+		}
+	}
+
+	if _, ok := u.values[PreBootstrapKey]; ok {
+		script, ok := u.PreBootstrapScript()
+		if !ok {
+			return errors.New("go-oracle-cloud: Invalid pre-bootstrap userdata")
+		}
+
+		if len(script.Script) == 0 && script.ScriptURL == "" {
+			return errors.New(
+				"go-oracle-cloud: Pre-bootstrap needs a script or a script url",
+			)
+		}
+
+		if len(script.Script) != 0 && script.ScriptURL != "" {
+			return errors.New(
+				"go-oracle-cloud: Pre-bootstrap can't have both a script and a script url",
+			)
+		}
+	}
+
+	raw, err := json.Marshal(u.values)
+	if err != nil {
+		return err
+	}
+
+	if len(raw) > MaxUserdataSize {
+		return fmt.Errorf(
+			"go-oracle-cloud: Userdata size %d is bigger than the maximum of %d bytes",
+			len(raw), MaxUserdataSize,
+		)
+	}
+
+	return nil
+}
+
+// Attributes validates the userdata and returns the instance
+// attributes that hold it, ready to be used in Instances.Attributes
+func (u *Userdata) Attributes() (map[string]interface{}, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(u.values))
+	for key, value := range u.values {
+		values[key] = value
+	}
+
+	return map[string]interface{}{"userdata": values}, nil
+}
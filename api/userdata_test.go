@@ -0,0 +1,71 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type userdataTest struct{}
+
+var _ = gc.Suite(&userdataTest{})
+
+func (u userdataTest) TestRoundTrip(c *gc.C) {
+	script := api.PreBootstrap{
+		Script:      []string{"#!/bin/bash", "yum -y install nginx"},
+		Failonerror: true,
+	}
+
+	attributes, err := api.NewUserdata().
+		Set("role", "web").
+		CloudConfig("#cloud-config\npackages:\n - nginx\n").
+		PreBootstrap(script).
+		Attributes()
+	c.Assert(err, gc.IsNil)
+
+	raw, err := json.Marshal(attributes)
+	c.Assert(err, gc.IsNil)
+
+	var decoded response.Attributes
+	err = json.Unmarshal(raw, &decoded)
+	c.Assert(err, gc.IsNil)
+
+	userdata := api.UserdataFromAttributes(decoded)
+
+	role, ok := userdata.Get("role")
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(role, gc.Equals, "web")
+
+	document, ok := userdata.CloudConfigDocument()
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(document, gc.Equals, "#cloud-config\npackages:\n - nginx\n")
+
+	got, ok := userdata.PreBootstrapScript()
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(got, gc.DeepEquals, script)
+
+	_, err = userdata.Attributes()
+	c.Assert(err, gc.IsNil)
+}
+
+func (u userdataTest) TestValidate(c *gc.C) {
+	_, err := api.NewUserdata().CloudConfig("packages: []").Attributes()
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: The cloud-config document should start with #cloud-config")
+
+	_, err = api.NewUserdata().PreBootstrap(api.PreBootstrap{}).Attributes()
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Pre-bootstrap needs a script or a script url")
+
+	_, err = api.NewUserdata().
+		Set("blob", strings.Repeat("x", api.MaxUserdataSize)).
+		Attributes()
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Userdata size .* is bigger than the maximum of 16384 bytes")
+}
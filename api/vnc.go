@@ -4,6 +4,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -12,6 +13,15 @@ import (
 
 // VirtualNic retrives a virtual nic with that has a given name
 func (c Client) VirtualNic(name string) (resp response.VirtualNic, err error) {
+	return c.virtualNic(nil, name)
+}
+
+// virtualNic retrieves the details of the virtual nic within the ctx
+func (c Client) virtualNic(
+	ctx context.Context,
+	name string,
+) (resp response.VirtualNic, err error) {
+
 	if !c.isAuth() {
 		return resp, ErrNotAuth
 	}
@@ -26,6 +36,7 @@ func (c Client) VirtualNic(name string) (resp response.VirtualNic, err error) {
 		c.endpoint, "network/v1/vnic", c.identify, c.username, name)
 
 	if err = request(paramsRequest{
+		ctx:    ctx,
 		client: &c.http,
 		cookie: c.cookie,
 		verb:   "GET",
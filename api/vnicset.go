@@ -0,0 +1,296 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// vnicSetRequest is the body of the vnic set create and update requests
+type vnicSetRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	AppliedAcls []string `json:"appliedAcls,omitempty"`
+	Vnics       []string `json:"vnics,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// vnicSetBody constructs the oracle cloud complaint body
+// for the vnic set create and update requests
+func (c Client) vnicSetBody(
+	name string,
+	description string,
+	vnics []string,
+	appliedAcls []string,
+	tags []string,
+) vnicSetRequest {
+
+	params := vnicSetRequest{
+		Name: fmt.Sprintf("/Compute-%s/%s/%s",
+			c.identify, c.username, name),
+		Description: description,
+		Tags:        tags,
+	}
+
+	for _, vnic := range vnics {
+		params.Vnics = append(params.Vnics, fmt.Sprintf(
+			"/Compute-%s/%s/%s", c.identify, c.username, vnic,
+		))
+	}
+
+	for _, acl := range appliedAcls {
+		params.AppliedAcls = append(params.AppliedAcls, fmt.Sprintf(
+			"/Compute-%s/%s/%s", c.identify, c.username, acl,
+		))
+	}
+
+	return params
+}
+
+// stripVnicSet strips all the names that the vnic set references
+func stripVnicSet(set *response.VnicSet) {
+	strip(&set.Name)
+	for key := range set.Vnics {
+		strip(&set.Vnics[key])
+	}
+	for key := range set.AppliedAcls {
+		strip(&set.AppliedAcls[key])
+	}
+}
+
+// CreateVnicSet creates a virtual NIC set with the given vnics
+// and the given applied acls. After creating a vNICset
+// you can reference it in security rules and routes.
+func (c Client) CreateVnicSet(
+	name string,
+	description string,
+	vnics []string,
+	appliedAcls []string,
+	tags []string,
+) (resp response.VnicSet, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty vnic set name")
+	}
+
+	params := c.vnicSetBody(name, description, vnics, appliedAcls, tags)
+
+	url := fmt.Sprintf("%s/network/v1/vnicset/", c.endpoint)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "POST",
+		body:   &params,
+		treat:  defaultPostTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripVnicSet(&resp)
+
+	return resp, nil
+}
+
+// DeleteVnicSet deletes the specified virtual NIC set.
+// Deleting a vNICset doesn't delete the vnics inside of it.
+func (c Client) DeleteVnicSet(name string) (err error) {
+	if !c.isAuth() {
+		return ErrNotAuth
+	}
+
+	if name == "" {
+		return errors.New("go-oracle-cloud: Empty vnic set name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/vnicset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "DELETE",
+		treat:  defaultDeleteTreat,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VnicSetDetails retrieves details of the specified virtual NIC set.
+func (c Client) VnicSetDetails(name string) (resp response.VnicSet, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if name == "" {
+		return resp, errors.New("go-oracle-cloud: Empty vnic set name")
+	}
+
+	url := fmt.Sprintf("%s/network/v1/vnicset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, name)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripVnicSet(&resp)
+
+	return resp, nil
+}
+
+// AllVnicSet retrieves details of all the virtual NIC sets
+// that are available in the account
+func (c Client) AllVnicSet() (resp response.AllVnicSet, err error) {
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	url := fmt.Sprintf("%s/network/v1/vnicset/Compute-%s/%s/",
+		c.endpoint, c.identify, c.username)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "GET",
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	for key := range resp.Result {
+		stripVnicSet(&resp.Result[key])
+	}
+
+	return resp, nil
+}
+
+// UpdateVnicSet updates the description, tags, vnics and the applied
+// acls of the specified virtual NIC set. Note that this replaces
+// the vnics of the set with the new ones, if you want to add or
+// remove a single vnic from the set use AddVnicToSet and RemoveVnicFromSet.
+// newName could be "" if you don't want to change the name.
+func (c Client) UpdateVnicSet(
+	currentName string,
+	newName string,
+	description string,
+	vnics []string,
+	appliedAcls []string,
+	tags []string,
+) (resp response.VnicSet, err error) {
+
+	if !c.isAuth() {
+		return resp, ErrNotAuth
+	}
+
+	if currentName == "" {
+		return resp, errors.New("go-oracle-cloud: Empty vnic set name")
+	}
+
+	if newName == "" {
+		newName = currentName
+	}
+
+	params := c.vnicSetBody(newName, description, vnics, appliedAcls, tags)
+
+	url := fmt.Sprintf("%s/network/v1/vnicset/Compute-%s/%s/%s",
+		c.endpoint, c.identify, c.username, currentName)
+
+	if err = request(paramsRequest{
+		client: &c.http,
+		cookie: c.cookie,
+		url:    url,
+		verb:   "PUT",
+		body:   &params,
+		treat:  defaultTreat,
+		resp:   &resp,
+	}); err != nil {
+		return resp, err
+	}
+
+	stripVnicSet(&resp)
+
+	return resp, nil
+}
+
+// AddVnicToSet adds the vnic to the specified virtual NIC set
+// keeping all the other vnics, acls, tags and description of the set.
+// If the vnic is already in the set the set is returned unchanged.
+func (c Client) AddVnicToSet(
+	name string,
+	vnic string,
+) (resp response.VnicSet, err error) {
+
+	if vnic == "" {
+		return resp, errors.New("go-oracle-cloud: Empty virtual nic name")
+	}
+
+	set, err := c.VnicSetDetails(name)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, v := range set.Vnics {
+		if v == vnic {
+			return set, nil
+		}
+	}
+
+	vnics := append(set.Vnics, vnic)
+
+	return c.UpdateVnicSet(name, "", set.Description,
+		vnics, set.AppliedAcls, set.Tags)
+}
+
+// RemoveVnicFromSet removes the vnic from the specified virtual NIC set
+// keeping all the other vnics, acls, tags and description of the set.
+// If the vnic is not in the set the set is returned unchanged.
+func (c Client) RemoveVnicFromSet(
+	name string,
+	vnic string,
+) (resp response.VnicSet, err error) {
+
+	if vnic == "" {
+		return resp, errors.New("go-oracle-cloud: Empty virtual nic name")
+	}
+
+	set, err := c.VnicSetDetails(name)
+	if err != nil {
+		return resp, err
+	}
+
+	vnics := make([]string, 0, len(set.Vnics))
+	for _, v := range set.Vnics {
+		if v != vnic {
+			vnics = append(vnics, v)
+		}
+	}
+
+	if len(vnics) == len(set.Vnics) {
+		return set, nil
+	}
+
+	return c.UpdateVnicSet(name, "", set.Description,
+		vnics, set.AppliedAcls, set.Tags)
+}
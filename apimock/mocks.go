@@ -0,0 +1,1465 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Code generated by mockgen from interfaces.go. DO NOT EDIT.
+
+package apimock
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+)
+
+// AuthAPI is a mock of api.AuthAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type AuthAPI struct {
+	Recorder
+
+	AuthenticateFunc     func() error
+	RefreshCookieFunc    func() error
+	AccountDetailsFunc   func(name string) (response.Account, error)
+	AllAccountDetaisFunc func() (response.AllAccount, error)
+	AllAccountNamesFunc  func() (response.DirectoryNames, error)
+	DirectoryAccountFunc func() (response.DirectoryNames, error)
+}
+
+var _ api.AuthAPI = (*AuthAPI)(nil)
+
+// Authenticate records the call and calls AuthenticateFunc
+func (m *AuthAPI) Authenticate() (r0 error) {
+	m.record("Authenticate")
+	if m.AuthenticateFunc != nil {
+		return m.AuthenticateFunc()
+	}
+	return
+}
+
+// RefreshCookie records the call and calls RefreshCookieFunc
+func (m *AuthAPI) RefreshCookie() (r0 error) {
+	m.record("RefreshCookie")
+	if m.RefreshCookieFunc != nil {
+		return m.RefreshCookieFunc()
+	}
+	return
+}
+
+// AccountDetails records the call and calls AccountDetailsFunc
+func (m *AuthAPI) AccountDetails(name string) (r0 response.Account, r1 error) {
+	m.record("AccountDetails", name)
+	if m.AccountDetailsFunc != nil {
+		return m.AccountDetailsFunc(name)
+	}
+	return
+}
+
+// AllAccountDetais records the call and calls AllAccountDetaisFunc
+func (m *AuthAPI) AllAccountDetais() (r0 response.AllAccount, r1 error) {
+	m.record("AllAccountDetais")
+	if m.AllAccountDetaisFunc != nil {
+		return m.AllAccountDetaisFunc()
+	}
+	return
+}
+
+// AllAccountNames records the call and calls AllAccountNamesFunc
+func (m *AuthAPI) AllAccountNames() (r0 response.DirectoryNames, r1 error) {
+	m.record("AllAccountNames")
+	if m.AllAccountNamesFunc != nil {
+		return m.AllAccountNamesFunc()
+	}
+	return
+}
+
+// DirectoryAccount records the call and calls DirectoryAccountFunc
+func (m *AuthAPI) DirectoryAccount() (r0 response.DirectoryNames, r1 error) {
+	m.record("DirectoryAccount")
+	if m.DirectoryAccountFunc != nil {
+		return m.DirectoryAccountFunc()
+	}
+	return
+}
+
+// InstanceAPI is a mock of api.InstanceAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type InstanceAPI struct {
+	Recorder
+
+	CreateInstanceFunc               func(params api.InstanceParams) (response.LaunchPlan, error)
+	DeleteInstanceFunc               func(name string) error
+	InstanceDetailsFunc              func(name string) (response.Instance, error)
+	AllInstancesFunc                 func() (response.AllInstance, error)
+	AllInstanceNamesFunc             func() (response.DirectoryNames, error)
+	ListInstancesFunc                func(ctx context.Context) *api.InstanceIterator
+	BulkInstanceDetailsFunc          func(ctx context.Context, names []string, workers int) []api.InstanceResult
+	CreateRebootInstanceRequestFunc  func(hard bool, instanceName string) (response.RebootInstanceRequest, error)
+	DeleteRebootInstanceRequestFunc  func(instanceName string) error
+	RebootInstanceRequestDetailsFunc func(instanceName string) (response.RebootInstanceRequest, error)
+	AllRebootInstanceRequestFunc     func() (response.AllRebootInstanceRequest, error)
+	InstanceConsoleDetailsFunc       func(name string) (response.InstanceConsole, error)
+	TailInstanceConsoleFunc          func(ctx context.Context, name string, w io.Writer, interval time.Duration) error
+	ShapeDetailsFunc                 func(name string) (response.Shape, error)
+	AllShapeDetailsFunc              func() (response.AllShape, error)
+	ShapeCatalogueFunc               func() (api.ShapeCatalogue, error)
+}
+
+var _ api.InstanceAPI = (*InstanceAPI)(nil)
+
+// CreateInstance records the call and calls CreateInstanceFunc
+func (m *InstanceAPI) CreateInstance(params api.InstanceParams) (r0 response.LaunchPlan, r1 error) {
+	m.record("CreateInstance", params)
+	if m.CreateInstanceFunc != nil {
+		return m.CreateInstanceFunc(params)
+	}
+	return
+}
+
+// DeleteInstance records the call and calls DeleteInstanceFunc
+func (m *InstanceAPI) DeleteInstance(name string) (r0 error) {
+	m.record("DeleteInstance", name)
+	if m.DeleteInstanceFunc != nil {
+		return m.DeleteInstanceFunc(name)
+	}
+	return
+}
+
+// InstanceDetails records the call and calls InstanceDetailsFunc
+func (m *InstanceAPI) InstanceDetails(name string) (r0 response.Instance, r1 error) {
+	m.record("InstanceDetails", name)
+	if m.InstanceDetailsFunc != nil {
+		return m.InstanceDetailsFunc(name)
+	}
+	return
+}
+
+// AllInstances records the call and calls AllInstancesFunc
+func (m *InstanceAPI) AllInstances() (r0 response.AllInstance, r1 error) {
+	m.record("AllInstances")
+	if m.AllInstancesFunc != nil {
+		return m.AllInstancesFunc()
+	}
+	return
+}
+
+// AllInstanceNames records the call and calls AllInstanceNamesFunc
+func (m *InstanceAPI) AllInstanceNames() (r0 response.DirectoryNames, r1 error) {
+	m.record("AllInstanceNames")
+	if m.AllInstanceNamesFunc != nil {
+		return m.AllInstanceNamesFunc()
+	}
+	return
+}
+
+// ListInstances records the call and calls ListInstancesFunc
+func (m *InstanceAPI) ListInstances(ctx context.Context) (r0 *api.InstanceIterator) {
+	m.record("ListInstances", ctx)
+	if m.ListInstancesFunc != nil {
+		return m.ListInstancesFunc(ctx)
+	}
+	return
+}
+
+// BulkInstanceDetails records the call and calls BulkInstanceDetailsFunc
+func (m *InstanceAPI) BulkInstanceDetails(ctx context.Context, names []string, workers int) (r0 []api.InstanceResult) {
+	m.record("BulkInstanceDetails", ctx, names, workers)
+	if m.BulkInstanceDetailsFunc != nil {
+		return m.BulkInstanceDetailsFunc(ctx, names, workers)
+	}
+	return
+}
+
+// CreateRebootInstanceRequest records the call and calls CreateRebootInstanceRequestFunc
+func (m *InstanceAPI) CreateRebootInstanceRequest(hard bool, instanceName string) (r0 response.RebootInstanceRequest, r1 error) {
+	m.record("CreateRebootInstanceRequest", hard, instanceName)
+	if m.CreateRebootInstanceRequestFunc != nil {
+		return m.CreateRebootInstanceRequestFunc(hard, instanceName)
+	}
+	return
+}
+
+// DeleteRebootInstanceRequest records the call and calls DeleteRebootInstanceRequestFunc
+func (m *InstanceAPI) DeleteRebootInstanceRequest(instanceName string) (r0 error) {
+	m.record("DeleteRebootInstanceRequest", instanceName)
+	if m.DeleteRebootInstanceRequestFunc != nil {
+		return m.DeleteRebootInstanceRequestFunc(instanceName)
+	}
+	return
+}
+
+// RebootInstanceRequestDetails records the call and calls RebootInstanceRequestDetailsFunc
+func (m *InstanceAPI) RebootInstanceRequestDetails(instanceName string) (r0 response.RebootInstanceRequest, r1 error) {
+	m.record("RebootInstanceRequestDetails", instanceName)
+	if m.RebootInstanceRequestDetailsFunc != nil {
+		return m.RebootInstanceRequestDetailsFunc(instanceName)
+	}
+	return
+}
+
+// AllRebootInstanceRequest records the call and calls AllRebootInstanceRequestFunc
+func (m *InstanceAPI) AllRebootInstanceRequest() (r0 response.AllRebootInstanceRequest, r1 error) {
+	m.record("AllRebootInstanceRequest")
+	if m.AllRebootInstanceRequestFunc != nil {
+		return m.AllRebootInstanceRequestFunc()
+	}
+	return
+}
+
+// InstanceConsoleDetails records the call and calls InstanceConsoleDetailsFunc
+func (m *InstanceAPI) InstanceConsoleDetails(name string) (r0 response.InstanceConsole, r1 error) {
+	m.record("InstanceConsoleDetails", name)
+	if m.InstanceConsoleDetailsFunc != nil {
+		return m.InstanceConsoleDetailsFunc(name)
+	}
+	return
+}
+
+// TailInstanceConsole records the call and calls TailInstanceConsoleFunc
+func (m *InstanceAPI) TailInstanceConsole(ctx context.Context, name string, w io.Writer, interval time.Duration) (r0 error) {
+	m.record("TailInstanceConsole", ctx, name, w, interval)
+	if m.TailInstanceConsoleFunc != nil {
+		return m.TailInstanceConsoleFunc(ctx, name, w, interval)
+	}
+	return
+}
+
+// ShapeDetails records the call and calls ShapeDetailsFunc
+func (m *InstanceAPI) ShapeDetails(name string) (r0 response.Shape, r1 error) {
+	m.record("ShapeDetails", name)
+	if m.ShapeDetailsFunc != nil {
+		return m.ShapeDetailsFunc(name)
+	}
+	return
+}
+
+// AllShapeDetails records the call and calls AllShapeDetailsFunc
+func (m *InstanceAPI) AllShapeDetails() (r0 response.AllShape, r1 error) {
+	m.record("AllShapeDetails")
+	if m.AllShapeDetailsFunc != nil {
+		return m.AllShapeDetailsFunc()
+	}
+	return
+}
+
+// ShapeCatalogue records the call and calls ShapeCatalogueFunc
+func (m *InstanceAPI) ShapeCatalogue() (r0 api.ShapeCatalogue, r1 error) {
+	m.record("ShapeCatalogue")
+	if m.ShapeCatalogueFunc != nil {
+		return m.ShapeCatalogueFunc()
+	}
+	return
+}
+
+// NetworkAPI is a mock of api.NetworkAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type NetworkAPI struct {
+	Recorder
+
+	CreateIpReservationFunc         func(currentName string, newName string, parentpool string, permanent bool, tags []string) (response.IpReservation, error)
+	DeleteIpReservationFunc         func(name string) error
+	IpReservationDetailsFunc        func(name string) (response.IpReservation, error)
+	AllIpReservationFunc            func() (response.AllIpReservation, error)
+	UpdateIpReservationFunc         func(currentName string, newName string, parentpool string, permanent bool, tags []string) (response.IpReservation, error)
+	CreateIpAssociationFunc         func(parentpool string, vcable string) (response.IpAssociation, error)
+	DeleteIpAssociationFunc         func(name string) error
+	IpAssociationDetailsFunc        func(name string) (response.IpAssociation, error)
+	AllIpAssociationFunc            func() (response.AllIpAssociation, error)
+	CreateIpFunc                    func(description string, ipAddressPrefix string, ipNetworkExchange string, name string, publicNaptEnabledFlag bool, tags []string) (response.Ip, error)
+	DeleteIpFunc                    func(name string) error
+	IpDetailsFunc                   func(name string) (response.Ip, error)
+	AllIpFunc                       func() (response.AllIp, error)
+	UpdateIpFunc                    func(currentName string, newName string, description string, ipNetworkExchange string, ipAddressPrefix string, publicNaptEnabledFlag bool, tags []string) (response.Ip, error)
+	CreateIpNetworkExchangeFunc     func(name string, description string, tags []string) (response.IpNetworkExchange, error)
+	DeleteIpNetworkExchangeFunc     func(name string) error
+	IpNetworkExchangeDetailsFunc    func(name string) (response.IpNetworkExchange, error)
+	AllIpNetworkExchangeFunc        func() (response.AllIpNetworkExchange, error)
+	CreateIpAddressReservationFunc  func(name string, description string, ipAddressPool response.IpAddressPool, tags []string) (response.IpAddressReservation, error)
+	DeleteIpAddressReservationFunc  func(name string) error
+	IpAddressReservationDetailsFunc func(name string) (response.IpAddressReservation, error)
+	AllIpAddressReservationFunc     func() (response.AllIpAddressReservation, error)
+	UpdateIpAddressReservationFunc  func(currentName string, newName string, description string, ipAddressPool response.IpAddressPool, tags []string) (response.IpAddressReservation, error)
+	CreateIpAddressAssociationFunc  func(description string, ipAddressReservation string, vnic string, name string, tags []string) (response.IpAddressAssociation, error)
+	DeleteIpAddressAssociationFunc  func(name string) error
+	IpAddressAssociationDetailsFunc func(name string) (response.IpAddressAssociation, error)
+	AllIpAddressAssociationFunc     func() (response.AllIpAddressAssociation, error)
+	UpdateIpAddressAssociationFunc  func(currentName string, ipAddressReservation string, vnic string, newName string) (response.IpAddressAssociation, error)
+	CreateIpAddressPrefixSetFunc    func(name string, description string, ipAddressPrefixes []string, tags []string) (response.IpAddressPrefixSet, error)
+	DeleteIpAddressPrefixSetFunc    func(name string) error
+	IpAddressPrefixSetDetailsFunc   func(name string) (response.IpAddressPrefixSet, error)
+	AllIpAddressPrefixSetFunc       func() (response.AllIpAddressPrefixSet, error)
+	UpdateIpAddressPrefixSetFunc    func(currentName string, newName string, description string, ipAddressPrefixes []string, tags []string) (response.IpAddressPrefixSet, error)
+	VirtualNicFunc                  func(name string) (response.VirtualNic, error)
+	AllVirtualNicFunc               func() (response.AllVirtualNic, error)
+	BulkVirtualNicFunc              func(ctx context.Context, names []string, workers int) []api.VirtualNicResult
+	CreateVnicSetFunc               func(name string, description string, vnics []string, appliedAcls []string, tags []string) (response.VnicSet, error)
+	DeleteVnicSetFunc               func(name string) error
+	VnicSetDetailsFunc              func(name string) (response.VnicSet, error)
+	AllVnicSetFunc                  func() (response.AllVnicSet, error)
+	UpdateVnicSetFunc               func(currentName string, newName string, description string, vnics []string, appliedAcls []string, tags []string) (response.VnicSet, error)
+	AddVnicToSetFunc                func(name string, vnic string) (response.VnicSet, error)
+	RemoveVnicFromSetFunc           func(name string, vnic string) (response.VnicSet, error)
+	CreateRouteFunc                 func(name string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (response.Route, error)
+	DeleteRouteFunc                 func(name string) error
+	RouteDetailsFunc                func(name string) (response.Route, error)
+	AllRouteFunc                    func() (response.AllRoute, error)
+	UpdateRouteFunc                 func(currentName string, newName string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (response.Route, error)
+}
+
+var _ api.NetworkAPI = (*NetworkAPI)(nil)
+
+// CreateIpReservation records the call and calls CreateIpReservationFunc
+func (m *NetworkAPI) CreateIpReservation(currentName string, newName string, parentpool string, permanent bool, tags []string) (r0 response.IpReservation, r1 error) {
+	m.record("CreateIpReservation", currentName, newName, parentpool, permanent, tags)
+	if m.CreateIpReservationFunc != nil {
+		return m.CreateIpReservationFunc(currentName, newName, parentpool, permanent, tags)
+	}
+	return
+}
+
+// DeleteIpReservation records the call and calls DeleteIpReservationFunc
+func (m *NetworkAPI) DeleteIpReservation(name string) (r0 error) {
+	m.record("DeleteIpReservation", name)
+	if m.DeleteIpReservationFunc != nil {
+		return m.DeleteIpReservationFunc(name)
+	}
+	return
+}
+
+// IpReservationDetails records the call and calls IpReservationDetailsFunc
+func (m *NetworkAPI) IpReservationDetails(name string) (r0 response.IpReservation, r1 error) {
+	m.record("IpReservationDetails", name)
+	if m.IpReservationDetailsFunc != nil {
+		return m.IpReservationDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpReservation records the call and calls AllIpReservationFunc
+func (m *NetworkAPI) AllIpReservation() (r0 response.AllIpReservation, r1 error) {
+	m.record("AllIpReservation")
+	if m.AllIpReservationFunc != nil {
+		return m.AllIpReservationFunc()
+	}
+	return
+}
+
+// UpdateIpReservation records the call and calls UpdateIpReservationFunc
+func (m *NetworkAPI) UpdateIpReservation(currentName string, newName string, parentpool string, permanent bool, tags []string) (r0 response.IpReservation, r1 error) {
+	m.record("UpdateIpReservation", currentName, newName, parentpool, permanent, tags)
+	if m.UpdateIpReservationFunc != nil {
+		return m.UpdateIpReservationFunc(currentName, newName, parentpool, permanent, tags)
+	}
+	return
+}
+
+// CreateIpAssociation records the call and calls CreateIpAssociationFunc
+func (m *NetworkAPI) CreateIpAssociation(parentpool string, vcable string) (r0 response.IpAssociation, r1 error) {
+	m.record("CreateIpAssociation", parentpool, vcable)
+	if m.CreateIpAssociationFunc != nil {
+		return m.CreateIpAssociationFunc(parentpool, vcable)
+	}
+	return
+}
+
+// DeleteIpAssociation records the call and calls DeleteIpAssociationFunc
+func (m *NetworkAPI) DeleteIpAssociation(name string) (r0 error) {
+	m.record("DeleteIpAssociation", name)
+	if m.DeleteIpAssociationFunc != nil {
+		return m.DeleteIpAssociationFunc(name)
+	}
+	return
+}
+
+// IpAssociationDetails records the call and calls IpAssociationDetailsFunc
+func (m *NetworkAPI) IpAssociationDetails(name string) (r0 response.IpAssociation, r1 error) {
+	m.record("IpAssociationDetails", name)
+	if m.IpAssociationDetailsFunc != nil {
+		return m.IpAssociationDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpAssociation records the call and calls AllIpAssociationFunc
+func (m *NetworkAPI) AllIpAssociation() (r0 response.AllIpAssociation, r1 error) {
+	m.record("AllIpAssociation")
+	if m.AllIpAssociationFunc != nil {
+		return m.AllIpAssociationFunc()
+	}
+	return
+}
+
+// CreateIp records the call and calls CreateIpFunc
+func (m *NetworkAPI) CreateIp(description string, ipAddressPrefix string, ipNetworkExchange string, name string, publicNaptEnabledFlag bool, tags []string) (r0 response.Ip, r1 error) {
+	m.record("CreateIp", description, ipAddressPrefix, ipNetworkExchange, name, publicNaptEnabledFlag, tags)
+	if m.CreateIpFunc != nil {
+		return m.CreateIpFunc(description, ipAddressPrefix, ipNetworkExchange, name, publicNaptEnabledFlag, tags)
+	}
+	return
+}
+
+// DeleteIp records the call and calls DeleteIpFunc
+func (m *NetworkAPI) DeleteIp(name string) (r0 error) {
+	m.record("DeleteIp", name)
+	if m.DeleteIpFunc != nil {
+		return m.DeleteIpFunc(name)
+	}
+	return
+}
+
+// IpDetails records the call and calls IpDetailsFunc
+func (m *NetworkAPI) IpDetails(name string) (r0 response.Ip, r1 error) {
+	m.record("IpDetails", name)
+	if m.IpDetailsFunc != nil {
+		return m.IpDetailsFunc(name)
+	}
+	return
+}
+
+// AllIp records the call and calls AllIpFunc
+func (m *NetworkAPI) AllIp() (r0 response.AllIp, r1 error) {
+	m.record("AllIp")
+	if m.AllIpFunc != nil {
+		return m.AllIpFunc()
+	}
+	return
+}
+
+// UpdateIp records the call and calls UpdateIpFunc
+func (m *NetworkAPI) UpdateIp(currentName string, newName string, description string, ipNetworkExchange string, ipAddressPrefix string, publicNaptEnabledFlag bool, tags []string) (r0 response.Ip, r1 error) {
+	m.record("UpdateIp", currentName, newName, description, ipNetworkExchange, ipAddressPrefix, publicNaptEnabledFlag, tags)
+	if m.UpdateIpFunc != nil {
+		return m.UpdateIpFunc(currentName, newName, description, ipNetworkExchange, ipAddressPrefix, publicNaptEnabledFlag, tags)
+	}
+	return
+}
+
+// CreateIpNetworkExchange records the call and calls CreateIpNetworkExchangeFunc
+func (m *NetworkAPI) CreateIpNetworkExchange(name string, description string, tags []string) (r0 response.IpNetworkExchange, r1 error) {
+	m.record("CreateIpNetworkExchange", name, description, tags)
+	if m.CreateIpNetworkExchangeFunc != nil {
+		return m.CreateIpNetworkExchangeFunc(name, description, tags)
+	}
+	return
+}
+
+// DeleteIpNetworkExchange records the call and calls DeleteIpNetworkExchangeFunc
+func (m *NetworkAPI) DeleteIpNetworkExchange(name string) (r0 error) {
+	m.record("DeleteIpNetworkExchange", name)
+	if m.DeleteIpNetworkExchangeFunc != nil {
+		return m.DeleteIpNetworkExchangeFunc(name)
+	}
+	return
+}
+
+// IpNetworkExchangeDetails records the call and calls IpNetworkExchangeDetailsFunc
+func (m *NetworkAPI) IpNetworkExchangeDetails(name string) (r0 response.IpNetworkExchange, r1 error) {
+	m.record("IpNetworkExchangeDetails", name)
+	if m.IpNetworkExchangeDetailsFunc != nil {
+		return m.IpNetworkExchangeDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpNetworkExchange records the call and calls AllIpNetworkExchangeFunc
+func (m *NetworkAPI) AllIpNetworkExchange() (r0 response.AllIpNetworkExchange, r1 error) {
+	m.record("AllIpNetworkExchange")
+	if m.AllIpNetworkExchangeFunc != nil {
+		return m.AllIpNetworkExchangeFunc()
+	}
+	return
+}
+
+// CreateIpAddressReservation records the call and calls CreateIpAddressReservationFunc
+func (m *NetworkAPI) CreateIpAddressReservation(name string, description string, ipAddressPool response.IpAddressPool, tags []string) (r0 response.IpAddressReservation, r1 error) {
+	m.record("CreateIpAddressReservation", name, description, ipAddressPool, tags)
+	if m.CreateIpAddressReservationFunc != nil {
+		return m.CreateIpAddressReservationFunc(name, description, ipAddressPool, tags)
+	}
+	return
+}
+
+// DeleteIpAddressReservation records the call and calls DeleteIpAddressReservationFunc
+func (m *NetworkAPI) DeleteIpAddressReservation(name string) (r0 error) {
+	m.record("DeleteIpAddressReservation", name)
+	if m.DeleteIpAddressReservationFunc != nil {
+		return m.DeleteIpAddressReservationFunc(name)
+	}
+	return
+}
+
+// IpAddressReservationDetails records the call and calls IpAddressReservationDetailsFunc
+func (m *NetworkAPI) IpAddressReservationDetails(name string) (r0 response.IpAddressReservation, r1 error) {
+	m.record("IpAddressReservationDetails", name)
+	if m.IpAddressReservationDetailsFunc != nil {
+		return m.IpAddressReservationDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpAddressReservation records the call and calls AllIpAddressReservationFunc
+func (m *NetworkAPI) AllIpAddressReservation() (r0 response.AllIpAddressReservation, r1 error) {
+	m.record("AllIpAddressReservation")
+	if m.AllIpAddressReservationFunc != nil {
+		return m.AllIpAddressReservationFunc()
+	}
+	return
+}
+
+// UpdateIpAddressReservation records the call and calls UpdateIpAddressReservationFunc
+func (m *NetworkAPI) UpdateIpAddressReservation(currentName string, newName string, description string, ipAddressPool response.IpAddressPool, tags []string) (r0 response.IpAddressReservation, r1 error) {
+	m.record("UpdateIpAddressReservation", currentName, newName, description, ipAddressPool, tags)
+	if m.UpdateIpAddressReservationFunc != nil {
+		return m.UpdateIpAddressReservationFunc(currentName, newName, description, ipAddressPool, tags)
+	}
+	return
+}
+
+// CreateIpAddressAssociation records the call and calls CreateIpAddressAssociationFunc
+func (m *NetworkAPI) CreateIpAddressAssociation(description string, ipAddressReservation string, vnic string, name string, tags []string) (r0 response.IpAddressAssociation, r1 error) {
+	m.record("CreateIpAddressAssociation", description, ipAddressReservation, vnic, name, tags)
+	if m.CreateIpAddressAssociationFunc != nil {
+		return m.CreateIpAddressAssociationFunc(description, ipAddressReservation, vnic, name, tags)
+	}
+	return
+}
+
+// DeleteIpAddressAssociation records the call and calls DeleteIpAddressAssociationFunc
+func (m *NetworkAPI) DeleteIpAddressAssociation(name string) (r0 error) {
+	m.record("DeleteIpAddressAssociation", name)
+	if m.DeleteIpAddressAssociationFunc != nil {
+		return m.DeleteIpAddressAssociationFunc(name)
+	}
+	return
+}
+
+// IpAddressAssociationDetails records the call and calls IpAddressAssociationDetailsFunc
+func (m *NetworkAPI) IpAddressAssociationDetails(name string) (r0 response.IpAddressAssociation, r1 error) {
+	m.record("IpAddressAssociationDetails", name)
+	if m.IpAddressAssociationDetailsFunc != nil {
+		return m.IpAddressAssociationDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpAddressAssociation records the call and calls AllIpAddressAssociationFunc
+func (m *NetworkAPI) AllIpAddressAssociation() (r0 response.AllIpAddressAssociation, r1 error) {
+	m.record("AllIpAddressAssociation")
+	if m.AllIpAddressAssociationFunc != nil {
+		return m.AllIpAddressAssociationFunc()
+	}
+	return
+}
+
+// UpdateIpAddressAssociation records the call and calls UpdateIpAddressAssociationFunc
+func (m *NetworkAPI) UpdateIpAddressAssociation(currentName string, ipAddressReservation string, vnic string, newName string) (r0 response.IpAddressAssociation, r1 error) {
+	m.record("UpdateIpAddressAssociation", currentName, ipAddressReservation, vnic, newName)
+	if m.UpdateIpAddressAssociationFunc != nil {
+		return m.UpdateIpAddressAssociationFunc(currentName, ipAddressReservation, vnic, newName)
+	}
+	return
+}
+
+// CreateIpAddressPrefixSet records the call and calls CreateIpAddressPrefixSetFunc
+func (m *NetworkAPI) CreateIpAddressPrefixSet(name string, description string, ipAddressPrefixes []string, tags []string) (r0 response.IpAddressPrefixSet, r1 error) {
+	m.record("CreateIpAddressPrefixSet", name, description, ipAddressPrefixes, tags)
+	if m.CreateIpAddressPrefixSetFunc != nil {
+		return m.CreateIpAddressPrefixSetFunc(name, description, ipAddressPrefixes, tags)
+	}
+	return
+}
+
+// DeleteIpAddressPrefixSet records the call and calls DeleteIpAddressPrefixSetFunc
+func (m *NetworkAPI) DeleteIpAddressPrefixSet(name string) (r0 error) {
+	m.record("DeleteIpAddressPrefixSet", name)
+	if m.DeleteIpAddressPrefixSetFunc != nil {
+		return m.DeleteIpAddressPrefixSetFunc(name)
+	}
+	return
+}
+
+// IpAddressPrefixSetDetails records the call and calls IpAddressPrefixSetDetailsFunc
+func (m *NetworkAPI) IpAddressPrefixSetDetails(name string) (r0 response.IpAddressPrefixSet, r1 error) {
+	m.record("IpAddressPrefixSetDetails", name)
+	if m.IpAddressPrefixSetDetailsFunc != nil {
+		return m.IpAddressPrefixSetDetailsFunc(name)
+	}
+	return
+}
+
+// AllIpAddressPrefixSet records the call and calls AllIpAddressPrefixSetFunc
+func (m *NetworkAPI) AllIpAddressPrefixSet() (r0 response.AllIpAddressPrefixSet, r1 error) {
+	m.record("AllIpAddressPrefixSet")
+	if m.AllIpAddressPrefixSetFunc != nil {
+		return m.AllIpAddressPrefixSetFunc()
+	}
+	return
+}
+
+// UpdateIpAddressPrefixSet records the call and calls UpdateIpAddressPrefixSetFunc
+func (m *NetworkAPI) UpdateIpAddressPrefixSet(currentName string, newName string, description string, ipAddressPrefixes []string, tags []string) (r0 response.IpAddressPrefixSet, r1 error) {
+	m.record("UpdateIpAddressPrefixSet", currentName, newName, description, ipAddressPrefixes, tags)
+	if m.UpdateIpAddressPrefixSetFunc != nil {
+		return m.UpdateIpAddressPrefixSetFunc(currentName, newName, description, ipAddressPrefixes, tags)
+	}
+	return
+}
+
+// VirtualNic records the call and calls VirtualNicFunc
+func (m *NetworkAPI) VirtualNic(name string) (r0 response.VirtualNic, r1 error) {
+	m.record("VirtualNic", name)
+	if m.VirtualNicFunc != nil {
+		return m.VirtualNicFunc(name)
+	}
+	return
+}
+
+// AllVirtualNic records the call and calls AllVirtualNicFunc
+func (m *NetworkAPI) AllVirtualNic() (r0 response.AllVirtualNic, r1 error) {
+	m.record("AllVirtualNic")
+	if m.AllVirtualNicFunc != nil {
+		return m.AllVirtualNicFunc()
+	}
+	return
+}
+
+// BulkVirtualNic records the call and calls BulkVirtualNicFunc
+func (m *NetworkAPI) BulkVirtualNic(ctx context.Context, names []string, workers int) (r0 []api.VirtualNicResult) {
+	m.record("BulkVirtualNic", ctx, names, workers)
+	if m.BulkVirtualNicFunc != nil {
+		return m.BulkVirtualNicFunc(ctx, names, workers)
+	}
+	return
+}
+
+// CreateVnicSet records the call and calls CreateVnicSetFunc
+func (m *NetworkAPI) CreateVnicSet(name string, description string, vnics []string, appliedAcls []string, tags []string) (r0 response.VnicSet, r1 error) {
+	m.record("CreateVnicSet", name, description, vnics, appliedAcls, tags)
+	if m.CreateVnicSetFunc != nil {
+		return m.CreateVnicSetFunc(name, description, vnics, appliedAcls, tags)
+	}
+	return
+}
+
+// DeleteVnicSet records the call and calls DeleteVnicSetFunc
+func (m *NetworkAPI) DeleteVnicSet(name string) (r0 error) {
+	m.record("DeleteVnicSet", name)
+	if m.DeleteVnicSetFunc != nil {
+		return m.DeleteVnicSetFunc(name)
+	}
+	return
+}
+
+// VnicSetDetails records the call and calls VnicSetDetailsFunc
+func (m *NetworkAPI) VnicSetDetails(name string) (r0 response.VnicSet, r1 error) {
+	m.record("VnicSetDetails", name)
+	if m.VnicSetDetailsFunc != nil {
+		return m.VnicSetDetailsFunc(name)
+	}
+	return
+}
+
+// AllVnicSet records the call and calls AllVnicSetFunc
+func (m *NetworkAPI) AllVnicSet() (r0 response.AllVnicSet, r1 error) {
+	m.record("AllVnicSet")
+	if m.AllVnicSetFunc != nil {
+		return m.AllVnicSetFunc()
+	}
+	return
+}
+
+// UpdateVnicSet records the call and calls UpdateVnicSetFunc
+func (m *NetworkAPI) UpdateVnicSet(currentName string, newName string, description string, vnics []string, appliedAcls []string, tags []string) (r0 response.VnicSet, r1 error) {
+	m.record("UpdateVnicSet", currentName, newName, description, vnics, appliedAcls, tags)
+	if m.UpdateVnicSetFunc != nil {
+		return m.UpdateVnicSetFunc(currentName, newName, description, vnics, appliedAcls, tags)
+	}
+	return
+}
+
+// AddVnicToSet records the call and calls AddVnicToSetFunc
+func (m *NetworkAPI) AddVnicToSet(name string, vnic string) (r0 response.VnicSet, r1 error) {
+	m.record("AddVnicToSet", name, vnic)
+	if m.AddVnicToSetFunc != nil {
+		return m.AddVnicToSetFunc(name, vnic)
+	}
+	return
+}
+
+// RemoveVnicFromSet records the call and calls RemoveVnicFromSetFunc
+func (m *NetworkAPI) RemoveVnicFromSet(name string, vnic string) (r0 response.VnicSet, r1 error) {
+	m.record("RemoveVnicFromSet", name, vnic)
+	if m.RemoveVnicFromSetFunc != nil {
+		return m.RemoveVnicFromSetFunc(name, vnic)
+	}
+	return
+}
+
+// CreateRoute records the call and calls CreateRouteFunc
+func (m *NetworkAPI) CreateRoute(name string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (r0 response.Route, r1 error) {
+	m.record("CreateRoute", name, description, adminDistance, ipAddressPrefix, nextHopVnicSet, tags)
+	if m.CreateRouteFunc != nil {
+		return m.CreateRouteFunc(name, description, adminDistance, ipAddressPrefix, nextHopVnicSet, tags)
+	}
+	return
+}
+
+// DeleteRoute records the call and calls DeleteRouteFunc
+func (m *NetworkAPI) DeleteRoute(name string) (r0 error) {
+	m.record("DeleteRoute", name)
+	if m.DeleteRouteFunc != nil {
+		return m.DeleteRouteFunc(name)
+	}
+	return
+}
+
+// RouteDetails records the call and calls RouteDetailsFunc
+func (m *NetworkAPI) RouteDetails(name string) (r0 response.Route, r1 error) {
+	m.record("RouteDetails", name)
+	if m.RouteDetailsFunc != nil {
+		return m.RouteDetailsFunc(name)
+	}
+	return
+}
+
+// AllRoute records the call and calls AllRouteFunc
+func (m *NetworkAPI) AllRoute() (r0 response.AllRoute, r1 error) {
+	m.record("AllRoute")
+	if m.AllRouteFunc != nil {
+		return m.AllRouteFunc()
+	}
+	return
+}
+
+// UpdateRoute records the call and calls UpdateRouteFunc
+func (m *NetworkAPI) UpdateRoute(currentName string, newName string, description string, adminDistance int, ipAddressPrefix string, nextHopVnicSet string, tags []string) (r0 response.Route, r1 error) {
+	m.record("UpdateRoute", currentName, newName, description, adminDistance, ipAddressPrefix, nextHopVnicSet, tags)
+	if m.UpdateRouteFunc != nil {
+		return m.UpdateRouteFunc(currentName, newName, description, adminDistance, ipAddressPrefix, nextHopVnicSet, tags)
+	}
+	return
+}
+
+// StorageAPI is a mock of api.StorageAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type StorageAPI struct {
+	Recorder
+
+	CreateStorageVolumeFunc        func(p api.StorageVolumeParams) (response.StorageVolume, error)
+	DeleteStorageVolumeFunc        func(name string) error
+	StorageVolumeDetailsFunc       func(name string) (response.StorageVolume, error)
+	AllStorageVolumeFunc           func() (response.AllStorageVolume, error)
+	ListStorageVolumesFunc         func(ctx context.Context) *api.StorageVolumeIterator
+	BulkStorageVolumeDetailsFunc   func(ctx context.Context, names []string, workers int) []api.StorageVolumeResult
+	StoragePropertyDetailsFunc     func(name string) (response.StorageProperty, error)
+	AllStoragePropertyFunc         func() (response.AllStorageProperty, error)
+	CreateBackupConfigurationFunc  func(p api.BackupConfigurationParams) (response.BackupConfiguration, error)
+	DeleteBackupConfigurationFunc  func(name string) error
+	BackupConfigurationDetailsFunc func(name string) (response.BackupConfiguration, error)
+	AllBackupConfigurationFunc     func() ([]response.BackupConfiguration, error)
+	UpdateBackupConfigurationFunc  func(p api.BackupConfigurationParams, newName string) (response.BackupConfiguration, error)
+}
+
+var _ api.StorageAPI = (*StorageAPI)(nil)
+
+// CreateStorageVolume records the call and calls CreateStorageVolumeFunc
+func (m *StorageAPI) CreateStorageVolume(p api.StorageVolumeParams) (r0 response.StorageVolume, r1 error) {
+	m.record("CreateStorageVolume", p)
+	if m.CreateStorageVolumeFunc != nil {
+		return m.CreateStorageVolumeFunc(p)
+	}
+	return
+}
+
+// DeleteStorageVolume records the call and calls DeleteStorageVolumeFunc
+func (m *StorageAPI) DeleteStorageVolume(name string) (r0 error) {
+	m.record("DeleteStorageVolume", name)
+	if m.DeleteStorageVolumeFunc != nil {
+		return m.DeleteStorageVolumeFunc(name)
+	}
+	return
+}
+
+// StorageVolumeDetails records the call and calls StorageVolumeDetailsFunc
+func (m *StorageAPI) StorageVolumeDetails(name string) (r0 response.StorageVolume, r1 error) {
+	m.record("StorageVolumeDetails", name)
+	if m.StorageVolumeDetailsFunc != nil {
+		return m.StorageVolumeDetailsFunc(name)
+	}
+	return
+}
+
+// AllStorageVolume records the call and calls AllStorageVolumeFunc
+func (m *StorageAPI) AllStorageVolume() (r0 response.AllStorageVolume, r1 error) {
+	m.record("AllStorageVolume")
+	if m.AllStorageVolumeFunc != nil {
+		return m.AllStorageVolumeFunc()
+	}
+	return
+}
+
+// ListStorageVolumes records the call and calls ListStorageVolumesFunc
+func (m *StorageAPI) ListStorageVolumes(ctx context.Context) (r0 *api.StorageVolumeIterator) {
+	m.record("ListStorageVolumes", ctx)
+	if m.ListStorageVolumesFunc != nil {
+		return m.ListStorageVolumesFunc(ctx)
+	}
+	return
+}
+
+// BulkStorageVolumeDetails records the call and calls BulkStorageVolumeDetailsFunc
+func (m *StorageAPI) BulkStorageVolumeDetails(ctx context.Context, names []string, workers int) (r0 []api.StorageVolumeResult) {
+	m.record("BulkStorageVolumeDetails", ctx, names, workers)
+	if m.BulkStorageVolumeDetailsFunc != nil {
+		return m.BulkStorageVolumeDetailsFunc(ctx, names, workers)
+	}
+	return
+}
+
+// StoragePropertyDetails records the call and calls StoragePropertyDetailsFunc
+func (m *StorageAPI) StoragePropertyDetails(name string) (r0 response.StorageProperty, r1 error) {
+	m.record("StoragePropertyDetails", name)
+	if m.StoragePropertyDetailsFunc != nil {
+		return m.StoragePropertyDetailsFunc(name)
+	}
+	return
+}
+
+// AllStorageProperty records the call and calls AllStoragePropertyFunc
+func (m *StorageAPI) AllStorageProperty() (r0 response.AllStorageProperty, r1 error) {
+	m.record("AllStorageProperty")
+	if m.AllStoragePropertyFunc != nil {
+		return m.AllStoragePropertyFunc()
+	}
+	return
+}
+
+// CreateBackupConfiguration records the call and calls CreateBackupConfigurationFunc
+func (m *StorageAPI) CreateBackupConfiguration(p api.BackupConfigurationParams) (r0 response.BackupConfiguration, r1 error) {
+	m.record("CreateBackupConfiguration", p)
+	if m.CreateBackupConfigurationFunc != nil {
+		return m.CreateBackupConfigurationFunc(p)
+	}
+	return
+}
+
+// DeleteBackupConfiguration records the call and calls DeleteBackupConfigurationFunc
+func (m *StorageAPI) DeleteBackupConfiguration(name string) (r0 error) {
+	m.record("DeleteBackupConfiguration", name)
+	if m.DeleteBackupConfigurationFunc != nil {
+		return m.DeleteBackupConfigurationFunc(name)
+	}
+	return
+}
+
+// BackupConfigurationDetails records the call and calls BackupConfigurationDetailsFunc
+func (m *StorageAPI) BackupConfigurationDetails(name string) (r0 response.BackupConfiguration, r1 error) {
+	m.record("BackupConfigurationDetails", name)
+	if m.BackupConfigurationDetailsFunc != nil {
+		return m.BackupConfigurationDetailsFunc(name)
+	}
+	return
+}
+
+// AllBackupConfiguration records the call and calls AllBackupConfigurationFunc
+func (m *StorageAPI) AllBackupConfiguration() (r0 []response.BackupConfiguration, r1 error) {
+	m.record("AllBackupConfiguration")
+	if m.AllBackupConfigurationFunc != nil {
+		return m.AllBackupConfigurationFunc()
+	}
+	return
+}
+
+// UpdateBackupConfiguration records the call and calls UpdateBackupConfigurationFunc
+func (m *StorageAPI) UpdateBackupConfiguration(p api.BackupConfigurationParams, newName string) (r0 response.BackupConfiguration, r1 error) {
+	m.record("UpdateBackupConfiguration", p, newName)
+	if m.UpdateBackupConfigurationFunc != nil {
+		return m.UpdateBackupConfigurationFunc(p, newName)
+	}
+	return
+}
+
+// SecurityAPI is a mock of api.SecurityAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type SecurityAPI struct {
+	Recorder
+
+	AddSHHKeyFunc                    func(name string, key string, enabled bool) (response.SSH, error)
+	DeleteSSHKeyFunc                 func(name string) error
+	SSHKeyDetailsFunc                func(name string) (response.SSH, error)
+	AllSSHKeyDetailsFunc             func() (response.AllSSH, error)
+	AllSSHKeyNamesFunc               func() (response.AllSSHNames, error)
+	UpdateSSHKeyFunc                 func(name string, key string, enabled bool) (response.SSH, error)
+	CreateSecListFunc                func(description string, name string, outbound_cidr_policy string, policy string) (response.SecList, error)
+	DeleteSecListFunc                func(name string) error
+	SecListDetailsFunc               func(name string) (response.SecList, error)
+	AllSecListFunc                   func() (response.AllSecList, error)
+	UpdateSecListFunc                func(description string, currentName string, newName string, outbound_cidr_policy string, policy string) (response.SecList, error)
+	CreateSecIpListFunc              func(description string, name string, secipentries []string) (response.SecIpList, error)
+	DeleteSecIpListFunc              func(name string) error
+	IpSecListDetailFunc              func(name string) (response.SecIpList, error)
+	AllSecIpListFunc                 func() (response.AllSecIpList, error)
+	UpdateSecIpListFunc              func(description string, currentName string, newName string, secipentries []string) (response.SecIpList, error)
+	CreateSecApplicationFunc         func(p api.SecApplicationParams) (response.SecApplication, error)
+	DeleteSecApplicationFunc         func(name string) error
+	SecApplicationDetailsFunc        func(name string) (response.SecApplication, error)
+	AllSecApplicationFunc            func() (response.AllSecApplication, error)
+	DefaultSecApplicationDetailsFunc func(name string) (response.SecApplication, error)
+	AllDefaultSecApplicationFunc     func() (response.AllSecApplication, error)
+	CreateSecAssociationFunc         func(name string, seclist string, vcable string) (response.SecAssociation, error)
+	DeleteSecAssociationFunc         func(name string) error
+	SecAssociationDetailsFunc        func(name string) (response.SecAssociation, error)
+	AllSecAssociationFunc            func() (response.AllSecAssociation, error)
+	InstanceVcableFunc               func(instanceName string) (string, error)
+	CreateAclFunc                    func(name string, description string, enabledFlag bool, tags []string) (response.Acl, error)
+	DeleteAclFunc                    func(name string) error
+	AclDetailsFunc                   func(name string) (response.Acl, error)
+	AllAclFunc                       func() (response.AllAcl, error)
+	UpdateAclFunc                    func(currentName string, newName string, description string, enableFlag bool, tags []string) (response.Acl, error)
+	CreateSecurityProtocolFunc       func(name string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (response.SecurityProtocol, error)
+	DeleteSecurityProtocolFunc       func(name string) error
+	SecurityProtocolDetailsFunc      func(name string) (response.SecurityProtocol, error)
+	AllSecurityProtocolFunc          func() (response.AllSecurityProtocol, error)
+	UpdateSecurityProtocolFunc       func(currentName string, newName string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (response.SecurityProtocol, error)
+	CreateSecurityRuleFunc           func(p api.SecurityRuleParams) (response.SecurityRule, error)
+	DeleteSecurityRuleFunc           func(name string) error
+	SecurityRuleDetailsFunc          func(name string) (response.SecurityRule, error)
+	AllSecurityRuleFunc              func() (response.AllSecurityRule, error)
+	UpdateSecurityRuleFunc           func(p api.SecurityRuleParams, newName string) (response.SecurityRule, error)
+}
+
+var _ api.SecurityAPI = (*SecurityAPI)(nil)
+
+// AddSHHKey records the call and calls AddSHHKeyFunc
+func (m *SecurityAPI) AddSHHKey(name string, key string, enabled bool) (r0 response.SSH, r1 error) {
+	m.record("AddSHHKey", name, key, enabled)
+	if m.AddSHHKeyFunc != nil {
+		return m.AddSHHKeyFunc(name, key, enabled)
+	}
+	return
+}
+
+// DeleteSSHKey records the call and calls DeleteSSHKeyFunc
+func (m *SecurityAPI) DeleteSSHKey(name string) (r0 error) {
+	m.record("DeleteSSHKey", name)
+	if m.DeleteSSHKeyFunc != nil {
+		return m.DeleteSSHKeyFunc(name)
+	}
+	return
+}
+
+// SSHKeyDetails records the call and calls SSHKeyDetailsFunc
+func (m *SecurityAPI) SSHKeyDetails(name string) (r0 response.SSH, r1 error) {
+	m.record("SSHKeyDetails", name)
+	if m.SSHKeyDetailsFunc != nil {
+		return m.SSHKeyDetailsFunc(name)
+	}
+	return
+}
+
+// AllSSHKeyDetails records the call and calls AllSSHKeyDetailsFunc
+func (m *SecurityAPI) AllSSHKeyDetails() (r0 response.AllSSH, r1 error) {
+	m.record("AllSSHKeyDetails")
+	if m.AllSSHKeyDetailsFunc != nil {
+		return m.AllSSHKeyDetailsFunc()
+	}
+	return
+}
+
+// AllSSHKeyNames records the call and calls AllSSHKeyNamesFunc
+func (m *SecurityAPI) AllSSHKeyNames() (r0 response.AllSSHNames, r1 error) {
+	m.record("AllSSHKeyNames")
+	if m.AllSSHKeyNamesFunc != nil {
+		return m.AllSSHKeyNamesFunc()
+	}
+	return
+}
+
+// UpdateSSHKey records the call and calls UpdateSSHKeyFunc
+func (m *SecurityAPI) UpdateSSHKey(name string, key string, enabled bool) (r0 response.SSH, r1 error) {
+	m.record("UpdateSSHKey", name, key, enabled)
+	if m.UpdateSSHKeyFunc != nil {
+		return m.UpdateSSHKeyFunc(name, key, enabled)
+	}
+	return
+}
+
+// CreateSecList records the call and calls CreateSecListFunc
+func (m *SecurityAPI) CreateSecList(description string, name string, outbound_cidr_policy string, policy string) (r0 response.SecList, r1 error) {
+	m.record("CreateSecList", description, name, outbound_cidr_policy, policy)
+	if m.CreateSecListFunc != nil {
+		return m.CreateSecListFunc(description, name, outbound_cidr_policy, policy)
+	}
+	return
+}
+
+// DeleteSecList records the call and calls DeleteSecListFunc
+func (m *SecurityAPI) DeleteSecList(name string) (r0 error) {
+	m.record("DeleteSecList", name)
+	if m.DeleteSecListFunc != nil {
+		return m.DeleteSecListFunc(name)
+	}
+	return
+}
+
+// SecListDetails records the call and calls SecListDetailsFunc
+func (m *SecurityAPI) SecListDetails(name string) (r0 response.SecList, r1 error) {
+	m.record("SecListDetails", name)
+	if m.SecListDetailsFunc != nil {
+		return m.SecListDetailsFunc(name)
+	}
+	return
+}
+
+// AllSecList records the call and calls AllSecListFunc
+func (m *SecurityAPI) AllSecList() (r0 response.AllSecList, r1 error) {
+	m.record("AllSecList")
+	if m.AllSecListFunc != nil {
+		return m.AllSecListFunc()
+	}
+	return
+}
+
+// UpdateSecList records the call and calls UpdateSecListFunc
+func (m *SecurityAPI) UpdateSecList(description string, currentName string, newName string, outbound_cidr_policy string, policy string) (r0 response.SecList, r1 error) {
+	m.record("UpdateSecList", description, currentName, newName, outbound_cidr_policy, policy)
+	if m.UpdateSecListFunc != nil {
+		return m.UpdateSecListFunc(description, currentName, newName, outbound_cidr_policy, policy)
+	}
+	return
+}
+
+// CreateSecIpList records the call and calls CreateSecIpListFunc
+func (m *SecurityAPI) CreateSecIpList(description string, name string, secipentries []string) (r0 response.SecIpList, r1 error) {
+	m.record("CreateSecIpList", description, name, secipentries)
+	if m.CreateSecIpListFunc != nil {
+		return m.CreateSecIpListFunc(description, name, secipentries)
+	}
+	return
+}
+
+// DeleteSecIpList records the call and calls DeleteSecIpListFunc
+func (m *SecurityAPI) DeleteSecIpList(name string) (r0 error) {
+	m.record("DeleteSecIpList", name)
+	if m.DeleteSecIpListFunc != nil {
+		return m.DeleteSecIpListFunc(name)
+	}
+	return
+}
+
+// IpSecListDetail records the call and calls IpSecListDetailFunc
+func (m *SecurityAPI) IpSecListDetail(name string) (r0 response.SecIpList, r1 error) {
+	m.record("IpSecListDetail", name)
+	if m.IpSecListDetailFunc != nil {
+		return m.IpSecListDetailFunc(name)
+	}
+	return
+}
+
+// AllSecIpList records the call and calls AllSecIpListFunc
+func (m *SecurityAPI) AllSecIpList() (r0 response.AllSecIpList, r1 error) {
+	m.record("AllSecIpList")
+	if m.AllSecIpListFunc != nil {
+		return m.AllSecIpListFunc()
+	}
+	return
+}
+
+// UpdateSecIpList records the call and calls UpdateSecIpListFunc
+func (m *SecurityAPI) UpdateSecIpList(description string, currentName string, newName string, secipentries []string) (r0 response.SecIpList, r1 error) {
+	m.record("UpdateSecIpList", description, currentName, newName, secipentries)
+	if m.UpdateSecIpListFunc != nil {
+		return m.UpdateSecIpListFunc(description, currentName, newName, secipentries)
+	}
+	return
+}
+
+// CreateSecApplication records the call and calls CreateSecApplicationFunc
+func (m *SecurityAPI) CreateSecApplication(p api.SecApplicationParams) (r0 response.SecApplication, r1 error) {
+	m.record("CreateSecApplication", p)
+	if m.CreateSecApplicationFunc != nil {
+		return m.CreateSecApplicationFunc(p)
+	}
+	return
+}
+
+// DeleteSecApplication records the call and calls DeleteSecApplicationFunc
+func (m *SecurityAPI) DeleteSecApplication(name string) (r0 error) {
+	m.record("DeleteSecApplication", name)
+	if m.DeleteSecApplicationFunc != nil {
+		return m.DeleteSecApplicationFunc(name)
+	}
+	return
+}
+
+// SecApplicationDetails records the call and calls SecApplicationDetailsFunc
+func (m *SecurityAPI) SecApplicationDetails(name string) (r0 response.SecApplication, r1 error) {
+	m.record("SecApplicationDetails", name)
+	if m.SecApplicationDetailsFunc != nil {
+		return m.SecApplicationDetailsFunc(name)
+	}
+	return
+}
+
+// AllSecApplication records the call and calls AllSecApplicationFunc
+func (m *SecurityAPI) AllSecApplication() (r0 response.AllSecApplication, r1 error) {
+	m.record("AllSecApplication")
+	if m.AllSecApplicationFunc != nil {
+		return m.AllSecApplicationFunc()
+	}
+	return
+}
+
+// DefaultSecApplicationDetails records the call and calls DefaultSecApplicationDetailsFunc
+func (m *SecurityAPI) DefaultSecApplicationDetails(name string) (r0 response.SecApplication, r1 error) {
+	m.record("DefaultSecApplicationDetails", name)
+	if m.DefaultSecApplicationDetailsFunc != nil {
+		return m.DefaultSecApplicationDetailsFunc(name)
+	}
+	return
+}
+
+// AllDefaultSecApplication records the call and calls AllDefaultSecApplicationFunc
+func (m *SecurityAPI) AllDefaultSecApplication() (r0 response.AllSecApplication, r1 error) {
+	m.record("AllDefaultSecApplication")
+	if m.AllDefaultSecApplicationFunc != nil {
+		return m.AllDefaultSecApplicationFunc()
+	}
+	return
+}
+
+// CreateSecAssociation records the call and calls CreateSecAssociationFunc
+func (m *SecurityAPI) CreateSecAssociation(name string, seclist string, vcable string) (r0 response.SecAssociation, r1 error) {
+	m.record("CreateSecAssociation", name, seclist, vcable)
+	if m.CreateSecAssociationFunc != nil {
+		return m.CreateSecAssociationFunc(name, seclist, vcable)
+	}
+	return
+}
+
+// DeleteSecAssociation records the call and calls DeleteSecAssociationFunc
+func (m *SecurityAPI) DeleteSecAssociation(name string) (r0 error) {
+	m.record("DeleteSecAssociation", name)
+	if m.DeleteSecAssociationFunc != nil {
+		return m.DeleteSecAssociationFunc(name)
+	}
+	return
+}
+
+// SecAssociationDetails records the call and calls SecAssociationDetailsFunc
+func (m *SecurityAPI) SecAssociationDetails(name string) (r0 response.SecAssociation, r1 error) {
+	m.record("SecAssociationDetails", name)
+	if m.SecAssociationDetailsFunc != nil {
+		return m.SecAssociationDetailsFunc(name)
+	}
+	return
+}
+
+// AllSecAssociation records the call and calls AllSecAssociationFunc
+func (m *SecurityAPI) AllSecAssociation() (r0 response.AllSecAssociation, r1 error) {
+	m.record("AllSecAssociation")
+	if m.AllSecAssociationFunc != nil {
+		return m.AllSecAssociationFunc()
+	}
+	return
+}
+
+// InstanceVcable records the call and calls InstanceVcableFunc
+func (m *SecurityAPI) InstanceVcable(instanceName string) (r0 string, r1 error) {
+	m.record("InstanceVcable", instanceName)
+	if m.InstanceVcableFunc != nil {
+		return m.InstanceVcableFunc(instanceName)
+	}
+	return
+}
+
+// CreateAcl records the call and calls CreateAclFunc
+func (m *SecurityAPI) CreateAcl(name string, description string, enabledFlag bool, tags []string) (r0 response.Acl, r1 error) {
+	m.record("CreateAcl", name, description, enabledFlag, tags)
+	if m.CreateAclFunc != nil {
+		return m.CreateAclFunc(name, description, enabledFlag, tags)
+	}
+	return
+}
+
+// DeleteAcl records the call and calls DeleteAclFunc
+func (m *SecurityAPI) DeleteAcl(name string) (r0 error) {
+	m.record("DeleteAcl", name)
+	if m.DeleteAclFunc != nil {
+		return m.DeleteAclFunc(name)
+	}
+	return
+}
+
+// AclDetails records the call and calls AclDetailsFunc
+func (m *SecurityAPI) AclDetails(name string) (r0 response.Acl, r1 error) {
+	m.record("AclDetails", name)
+	if m.AclDetailsFunc != nil {
+		return m.AclDetailsFunc(name)
+	}
+	return
+}
+
+// AllAcl records the call and calls AllAclFunc
+func (m *SecurityAPI) AllAcl() (r0 response.AllAcl, r1 error) {
+	m.record("AllAcl")
+	if m.AllAclFunc != nil {
+		return m.AllAclFunc()
+	}
+	return
+}
+
+// UpdateAcl records the call and calls UpdateAclFunc
+func (m *SecurityAPI) UpdateAcl(currentName string, newName string, description string, enableFlag bool, tags []string) (r0 response.Acl, r1 error) {
+	m.record("UpdateAcl", currentName, newName, description, enableFlag, tags)
+	if m.UpdateAclFunc != nil {
+		return m.UpdateAclFunc(currentName, newName, description, enableFlag, tags)
+	}
+	return
+}
+
+// CreateSecurityProtocol records the call and calls CreateSecurityProtocolFunc
+func (m *SecurityAPI) CreateSecurityProtocol(name string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (r0 response.SecurityProtocol, r1 error) {
+	m.record("CreateSecurityProtocol", name, description, ipProtocol, srcPortSet, dstPortSet, tags)
+	if m.CreateSecurityProtocolFunc != nil {
+		return m.CreateSecurityProtocolFunc(name, description, ipProtocol, srcPortSet, dstPortSet, tags)
+	}
+	return
+}
+
+// DeleteSecurityProtocol records the call and calls DeleteSecurityProtocolFunc
+func (m *SecurityAPI) DeleteSecurityProtocol(name string) (r0 error) {
+	m.record("DeleteSecurityProtocol", name)
+	if m.DeleteSecurityProtocolFunc != nil {
+		return m.DeleteSecurityProtocolFunc(name)
+	}
+	return
+}
+
+// SecurityProtocolDetails records the call and calls SecurityProtocolDetailsFunc
+func (m *SecurityAPI) SecurityProtocolDetails(name string) (r0 response.SecurityProtocol, r1 error) {
+	m.record("SecurityProtocolDetails", name)
+	if m.SecurityProtocolDetailsFunc != nil {
+		return m.SecurityProtocolDetailsFunc(name)
+	}
+	return
+}
+
+// AllSecurityProtocol records the call and calls AllSecurityProtocolFunc
+func (m *SecurityAPI) AllSecurityProtocol() (r0 response.AllSecurityProtocol, r1 error) {
+	m.record("AllSecurityProtocol")
+	if m.AllSecurityProtocolFunc != nil {
+		return m.AllSecurityProtocolFunc()
+	}
+	return
+}
+
+// UpdateSecurityProtocol records the call and calls UpdateSecurityProtocolFunc
+func (m *SecurityAPI) UpdateSecurityProtocol(currentName string, newName string, description string, ipProtocol string, srcPortSet []string, dstPortSet []string, tags []string) (r0 response.SecurityProtocol, r1 error) {
+	m.record("UpdateSecurityProtocol", currentName, newName, description, ipProtocol, srcPortSet, dstPortSet, tags)
+	if m.UpdateSecurityProtocolFunc != nil {
+		return m.UpdateSecurityProtocolFunc(currentName, newName, description, ipProtocol, srcPortSet, dstPortSet, tags)
+	}
+	return
+}
+
+// CreateSecurityRule records the call and calls CreateSecurityRuleFunc
+func (m *SecurityAPI) CreateSecurityRule(p api.SecurityRuleParams) (r0 response.SecurityRule, r1 error) {
+	m.record("CreateSecurityRule", p)
+	if m.CreateSecurityRuleFunc != nil {
+		return m.CreateSecurityRuleFunc(p)
+	}
+	return
+}
+
+// DeleteSecurityRule records the call and calls DeleteSecurityRuleFunc
+func (m *SecurityAPI) DeleteSecurityRule(name string) (r0 error) {
+	m.record("DeleteSecurityRule", name)
+	if m.DeleteSecurityRuleFunc != nil {
+		return m.DeleteSecurityRuleFunc(name)
+	}
+	return
+}
+
+// SecurityRuleDetails records the call and calls SecurityRuleDetailsFunc
+func (m *SecurityAPI) SecurityRuleDetails(name string) (r0 response.SecurityRule, r1 error) {
+	m.record("SecurityRuleDetails", name)
+	if m.SecurityRuleDetailsFunc != nil {
+		return m.SecurityRuleDetailsFunc(name)
+	}
+	return
+}
+
+// AllSecurityRule records the call and calls AllSecurityRuleFunc
+func (m *SecurityAPI) AllSecurityRule() (r0 response.AllSecurityRule, r1 error) {
+	m.record("AllSecurityRule")
+	if m.AllSecurityRuleFunc != nil {
+		return m.AllSecurityRuleFunc()
+	}
+	return
+}
+
+// UpdateSecurityRule records the call and calls UpdateSecurityRuleFunc
+func (m *SecurityAPI) UpdateSecurityRule(p api.SecurityRuleParams, newName string) (r0 response.SecurityRule, r1 error) {
+	m.record("UpdateSecurityRule", p, newName)
+	if m.UpdateSecurityRuleFunc != nil {
+		return m.UpdateSecurityRuleFunc(p, newName)
+	}
+	return
+}
+
+// ImageAPI is a mock of api.ImageAPI.
+// Every call is recorded and answered by the func field
+// of the method, or with zero values if the field is nil.
+type ImageAPI struct {
+	Recorder
+
+	CreateImageListFunc       func(def int, description string, name string) (response.ImageList, error)
+	DeleteImageListFunc       func(name string) error
+	ImageListDetailsFunc      func(name string) (response.ImageList, error)
+	AllImageListFunc          func() (response.AllImageList, error)
+	AllImageListNamesFunc     func() (response.DirectoryNames, error)
+	UpdateImageListFunc       func(currentName string, newName string, description string, def int) (response.ImageList, error)
+	ImageListEntryFunc        func(name string, version string) (response.ImageListEntry, error)
+	AddImageListEntryFunc     func(name string, attributes map[string]interface{}, version int, machineImages []string) (response.ImageListEntryAdd, error)
+	DeleteImageListEntryFunc  func(name string, version string) error
+	ImageListVersionsFunc     func(name string) ([]response.ImageListEntry, error)
+	LatestImageListEntryFunc  func(name string) (response.ImageListEntry, error)
+	DefaultImageListEntryFunc func(name string) (response.ImageListEntry, error)
+	PublishImageListEntryFunc func(name string, attributes map[string]interface{}, machineImages []string, setDefault bool) (response.ImageListEntryAdd, error)
+	PruneImageListEntriesFunc func(name string, keep int) ([]int, error)
+}
+
+var _ api.ImageAPI = (*ImageAPI)(nil)
+
+// CreateImageList records the call and calls CreateImageListFunc
+func (m *ImageAPI) CreateImageList(def int, description string, name string) (r0 response.ImageList, r1 error) {
+	m.record("CreateImageList", def, description, name)
+	if m.CreateImageListFunc != nil {
+		return m.CreateImageListFunc(def, description, name)
+	}
+	return
+}
+
+// DeleteImageList records the call and calls DeleteImageListFunc
+func (m *ImageAPI) DeleteImageList(name string) (r0 error) {
+	m.record("DeleteImageList", name)
+	if m.DeleteImageListFunc != nil {
+		return m.DeleteImageListFunc(name)
+	}
+	return
+}
+
+// ImageListDetails records the call and calls ImageListDetailsFunc
+func (m *ImageAPI) ImageListDetails(name string) (r0 response.ImageList, r1 error) {
+	m.record("ImageListDetails", name)
+	if m.ImageListDetailsFunc != nil {
+		return m.ImageListDetailsFunc(name)
+	}
+	return
+}
+
+// AllImageList records the call and calls AllImageListFunc
+func (m *ImageAPI) AllImageList() (r0 response.AllImageList, r1 error) {
+	m.record("AllImageList")
+	if m.AllImageListFunc != nil {
+		return m.AllImageListFunc()
+	}
+	return
+}
+
+// AllImageListNames records the call and calls AllImageListNamesFunc
+func (m *ImageAPI) AllImageListNames() (r0 response.DirectoryNames, r1 error) {
+	m.record("AllImageListNames")
+	if m.AllImageListNamesFunc != nil {
+		return m.AllImageListNamesFunc()
+	}
+	return
+}
+
+// UpdateImageList records the call and calls UpdateImageListFunc
+func (m *ImageAPI) UpdateImageList(currentName string, newName string, description string, def int) (r0 response.ImageList, r1 error) {
+	m.record("UpdateImageList", currentName, newName, description, def)
+	if m.UpdateImageListFunc != nil {
+		return m.UpdateImageListFunc(currentName, newName, description, def)
+	}
+	return
+}
+
+// ImageListEntry records the call and calls ImageListEntryFunc
+func (m *ImageAPI) ImageListEntry(name string, version string) (r0 response.ImageListEntry, r1 error) {
+	m.record("ImageListEntry", name, version)
+	if m.ImageListEntryFunc != nil {
+		return m.ImageListEntryFunc(name, version)
+	}
+	return
+}
+
+// AddImageListEntry records the call and calls AddImageListEntryFunc
+func (m *ImageAPI) AddImageListEntry(name string, attributes map[string]interface{}, version int, machineImages []string) (r0 response.ImageListEntryAdd, r1 error) {
+	m.record("AddImageListEntry", name, attributes, version, machineImages)
+	if m.AddImageListEntryFunc != nil {
+		return m.AddImageListEntryFunc(name, attributes, version, machineImages)
+	}
+	return
+}
+
+// DeleteImageListEntry records the call and calls DeleteImageListEntryFunc
+func (m *ImageAPI) DeleteImageListEntry(name string, version string) (r0 error) {
+	m.record("DeleteImageListEntry", name, version)
+	if m.DeleteImageListEntryFunc != nil {
+		return m.DeleteImageListEntryFunc(name, version)
+	}
+	return
+}
+
+// ImageListVersions records the call and calls ImageListVersionsFunc
+func (m *ImageAPI) ImageListVersions(name string) (r0 []response.ImageListEntry, r1 error) {
+	m.record("ImageListVersions", name)
+	if m.ImageListVersionsFunc != nil {
+		return m.ImageListVersionsFunc(name)
+	}
+	return
+}
+
+// LatestImageListEntry records the call and calls LatestImageListEntryFunc
+func (m *ImageAPI) LatestImageListEntry(name string) (r0 response.ImageListEntry, r1 error) {
+	m.record("LatestImageListEntry", name)
+	if m.LatestImageListEntryFunc != nil {
+		return m.LatestImageListEntryFunc(name)
+	}
+	return
+}
+
+// DefaultImageListEntry records the call and calls DefaultImageListEntryFunc
+func (m *ImageAPI) DefaultImageListEntry(name string) (r0 response.ImageListEntry, r1 error) {
+	m.record("DefaultImageListEntry", name)
+	if m.DefaultImageListEntryFunc != nil {
+		return m.DefaultImageListEntryFunc(name)
+	}
+	return
+}
+
+// PublishImageListEntry records the call and calls PublishImageListEntryFunc
+func (m *ImageAPI) PublishImageListEntry(name string, attributes map[string]interface{}, machineImages []string, setDefault bool) (r0 response.ImageListEntryAdd, r1 error) {
+	m.record("PublishImageListEntry", name, attributes, machineImages, setDefault)
+	if m.PublishImageListEntryFunc != nil {
+		return m.PublishImageListEntryFunc(name, attributes, machineImages, setDefault)
+	}
+	return
+}
+
+// PruneImageListEntries records the call and calls PruneImageListEntriesFunc
+func (m *ImageAPI) PruneImageListEntries(name string, keep int) (r0 []int, r1 error) {
+	m.record("PruneImageListEntries", name, keep)
+	if m.PruneImageListEntriesFunc != nil {
+		return m.PruneImageListEntriesFunc(name, keep)
+	}
+	return
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apimock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/apimock"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
+
+type mockTest struct{}
+
+var _ = gc.Suite(&mockTest{})
+
+// stopAll is downstream code that depends only on the interface
+func stopAll(instances api.InstanceAPI) error {
+	all, err := instances.AllInstances()
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range all.Result {
+		if err = instances.DeleteInstance(instance.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m mockTest) TestRecordAndCanned(c *gc.C) {
+	mock := &apimock.InstanceAPI{
+		AllInstancesFunc: func() (response.AllInstance, error) {
+			return response.AllInstance{Result: []response.Instance{
+				{Name: "web/1"}, {Name: "web/2"},
+			}}, nil
+		},
+	}
+
+	c.Assert(stopAll(mock), gc.IsNil)
+
+	calls := mock.Calls()
+	c.Assert(calls, gc.HasLen, 3)
+	c.Assert(calls[0], gc.DeepEquals, apimock.Call{Method: "AllInstances"})
+	c.Assert(mock.CallsTo("DeleteInstance"), gc.DeepEquals, []apimock.Call{
+		{Method: "DeleteInstance", Args: []interface{}{"web/1"}},
+		{Method: "DeleteInstance", Args: []interface{}{"web/2"}},
+	})
+
+	mock.Reset()
+	mock.DeleteInstanceFunc = func(name string) error {
+		return errors.New("boom")
+	}
+
+	c.Assert(stopAll(mock), gc.ErrorMatches, "boom")
+	c.Assert(mock.Calls(), gc.HasLen, 2)
+}
+
+// instanceNames is downstream code that lists the instances
+func instanceNames(instances api.InstanceAPI) ([]string, error) {
+	it := instances.ListInstances(context.Background())
+	defer it.Close()
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Instance().Name)
+	}
+
+	return names, it.Err()
+}
+
+func (m mockTest) TestCannedIterator(c *gc.C) {
+	// the nil iterator returned by default has no instances
+	mock := &apimock.InstanceAPI{}
+	names, err := instanceNames(mock)
+	c.Assert(err, gc.IsNil)
+	c.Assert(names, gc.HasLen, 0)
+
+	mock.ListInstancesFunc = func(ctx context.Context) *api.InstanceIterator {
+		return api.NewInstanceIterator([]response.Instance{
+			{Name: "web/1"}, {Name: "web/2"},
+		}, errors.New("connection reset"))
+	}
+
+	names, err = instanceNames(mock)
+	c.Assert(err, gc.ErrorMatches, "connection reset")
+	c.Assert(names, gc.DeepEquals, []string{"web/1", "web/2"})
+	c.Assert(mock.CallsTo("ListInstances"), gc.HasLen, 2)
+}
+
+// refreshAndList is downstream code that refreshes the
+// session through the interface when it expires
+func refreshAndList(auth api.AuthAPI) (response.DirectoryNames, error) {
+	if err := auth.RefreshCookie(); err != nil {
+		if err = auth.Authenticate(); err != nil {
+			return response.DirectoryNames{}, err
+		}
+	}
+
+	return auth.AllAccountNames()
+}
+
+func (m mockTest) TestAuth(c *gc.C) {
+	mock := &apimock.AuthAPI{
+		RefreshCookieFunc: func() error {
+			return errors.New("expired")
+		},
+		AllAccountNamesFunc: func() (response.DirectoryNames, error) {
+			return response.DirectoryNames{Result: []string{"default"}}, nil
+		},
+	}
+
+	names, err := refreshAndList(mock)
+	c.Assert(err, gc.IsNil)
+	c.Assert(names.Result, gc.DeepEquals, []string{"default"})
+	c.Assert(mock.Calls(), gc.DeepEquals, []apimock.Call{
+		{Method: "RefreshCookie"},
+		{Method: "Authenticate"},
+		{Method: "AllAccountNames"},
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package apimock provides mock implementations of the api
+// interfaces, so the code that uses the client could be tested
+// without a server. The mocks are generated from api/interfaces.go
+// with go generate; only this file is written by hand.
+package apimock
+
+import "sync"
+
+// Call is a recorded call of a mock method
+type Call struct {
+	// Method is the name of the called method
+	Method string
+
+	// Args are the arguments of the call
+	Args []interface{}
+}
+
+// Recorder records the calls of a mock.
+// It's safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// record appends the call of the method with the given arguments
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all the recorded calls in the order they were made
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	calls := make([]Call, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of the given method
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets all the recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
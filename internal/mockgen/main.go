@@ -0,0 +1,324 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Command mockgen generates the mock implementations of the
+// interfaces declared in a go source file. Every mock records
+// its calls and answers them with a func field per method.
+//
+// Usage:
+//
+//	mockgen -source interfaces.go -output mocks.go -package apimock \
+//		-import github.com/hoenirvili/go-oracle-cloud/api
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	source  = flag.String("source", "", "go source file with the interfaces")
+	output  = flag.String("output", "", "file where the mocks are written")
+	pkg     = flag.String("package", "", "package name of the mocks")
+	imprt   = flag.String("import", "", "import path of the source package")
+	header  = "// Copyright 2017 Canonical Ltd.\n// Licensed under the AGPLv3, see LICENCE file for details.\n\n"
+	warning = "// Code generated by mockgen from %s. DO NOT EDIT.\n\n"
+)
+
+func main() {
+	flag.Parse()
+
+	if *source == "" || *output == "" || *pkg == "" || *imprt == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := generate(); err != nil {
+		fmt.Fprintf(os.Stderr, "mockgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// method is a method of an interface
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+// param is a named parameter of a method
+type param struct {
+	name string
+	typ  string
+}
+
+// generator holds the state of the generation
+type generator struct {
+	// local is the package name of the source package
+	local string
+	// imports are the import paths of the source file by name
+	imports map[string]string
+	// used are the import names used by the mocks
+	used map[string]bool
+}
+
+func generate() error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	g := generator{
+		local:   file.Name.Name,
+		imports: make(map[string]string),
+		used:    map[string]bool{file.Name.Name: true},
+	}
+
+	for _, spec := range file.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		g.imports[name] = p
+	}
+	g.imports[g.local] = *imprt
+
+	var body bytes.Buffer
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+
+			methods, err := g.methods(it)
+			if err != nil {
+				return fmt.Errorf("%s: %v", ts.Name.Name, err)
+			}
+
+			g.mock(&body, ts.Name.Name, methods)
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, warning, path.Base(*source))
+	fmt.Fprintf(&out, "package %s\n\nimport (\n", *pkg)
+
+	names := make([]string, 0, len(g.used))
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	// the standard library imports go first
+	var std, other []string
+	for _, name := range names {
+		p := g.imports[name]
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			other = append(other, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	for _, p := range std {
+		fmt.Fprintf(&out, "\t%q\n", p)
+	}
+	if len(std) != 0 && len(other) != 0 {
+		out.WriteString("\n")
+	}
+	for _, p := range other {
+		fmt.Fprintf(&out, "\t%q\n", p)
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(*output, src, 0644)
+}
+
+// methods returns the methods of the interface
+func (g *generator) methods(it *ast.InterfaceType) ([]method, error) {
+	var methods []method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+
+		m := method{name: field.Names[0].Name}
+		for _, p := range ft.Params.List {
+			typ, err := g.expr(p.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(p.Names) == 0 {
+				m.params = append(m.params, param{
+					name: fmt.Sprintf("p%d", len(m.params)),
+					typ:  typ,
+				})
+			}
+
+			for _, name := range p.Names {
+				m.params = append(m.params, param{name: name.Name, typ: typ})
+			}
+		}
+
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				typ, err := g.expr(r.Type)
+				if err != nil {
+					return nil, err
+				}
+
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					m.results = append(m.results, typ)
+				}
+			}
+		}
+
+		methods = append(methods, m)
+	}
+
+	return methods, nil
+}
+
+// expr returns the type expression as it's seen from the
+// mock package, qualifying the types of the source package
+func (g *generator) expr(e ast.Expr) (string, error) {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return g.local + "." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector %T", t.X)
+		}
+		if _, ok := g.imports[x.Name]; !ok {
+			return "", fmt.Errorf("unknown package %s", x.Name)
+		}
+		g.used[x.Name] = true
+		return x.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		x, err := g.expr(t.X)
+		return "*" + x, err
+	case *ast.ArrayType:
+		x, err := g.expr(t.Elt)
+		return "[]" + x, err
+	case *ast.Ellipsis:
+		x, err := g.expr(t.Elt)
+		return "..." + x, err
+	case *ast.MapType:
+		k, err := g.expr(t.Key)
+		if err != nil {
+			return "", err
+		}
+		v, err := g.expr(t.Value)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) != 0 {
+			return "", fmt.Errorf("unsupported non empty interface type")
+		}
+		return "interface{}", nil
+	default:
+		return "", fmt.Errorf("unsupported type %T", e)
+	}
+}
+
+// mock writes the mock of the interface
+func (g *generator) mock(w *bytes.Buffer, name string, methods []method) {
+	fmt.Fprintf(w, "\n// %s is a mock of %s.%s.\n", name, g.local, name)
+	fmt.Fprintf(w, "// Every call is recorded and answered by the func field\n")
+	fmt.Fprintf(w, "// of the method, or with zero values if the field is nil.\n")
+	fmt.Fprintf(w, "type %s struct {\n\tRecorder\n\n", name)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t%sFunc func(%s) %s\n", m.name, m.signature(), m.returns(false))
+	}
+	fmt.Fprintf(w, "}\n\nvar _ %s.%s = (*%s)(nil)\n", g.local, name, name)
+
+	for _, m := range methods {
+		args := make([]string, 0, len(m.params))
+		for _, p := range m.params {
+			a := p.name
+			if strings.HasPrefix(p.typ, "...") {
+				a += "..."
+			}
+			args = append(args, a)
+		}
+
+		fmt.Fprintf(w, "\n// %s records the call and calls %sFunc\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s(%s) %s {\n", name, m.name, m.signature(), m.returns(true))
+
+		record := make([]string, 0, len(m.params)+1)
+		record = append(record, strconv.Quote(m.name))
+		for _, p := range m.params {
+			record = append(record, p.name)
+		}
+		fmt.Fprintf(w, "\tm.record(%s)\n", strings.Join(record, ", "))
+		fmt.Fprintf(w, "\tif m.%sFunc != nil {\n", m.name)
+		if len(m.results) == 0 {
+			fmt.Fprintf(w, "\t\tm.%sFunc(%s)\n\t}\n}\n", m.name, strings.Join(args, ", "))
+		} else {
+			fmt.Fprintf(w, "\t\treturn m.%sFunc(%s)\n\t}\n\treturn\n}\n", m.name, strings.Join(args, ", "))
+		}
+	}
+}
+
+// signature returns the parameters of the method
+func (m method) signature() string {
+	params := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+	}
+	return strings.Join(params, ", ")
+}
+
+// returns returns the results of the method,
+// named r0, r1... if named is true
+func (m method) returns(named bool) string {
+	results := make([]string, 0, len(m.results))
+	for i, r := range m.results {
+		if named {
+			r = fmt.Sprintf("r%d %s", i, r)
+		}
+		results = append(results, r)
+	}
+
+	if len(results) == 1 && !named {
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
@@ -0,0 +1,196 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault is a misbehaviour of the fake server that is injected
+// with Inject, to test the retry and the cleanup logic of the
+// code that uses the client.
+type Fault struct {
+	// Method is the http verb of the requests that the
+	// fault applies to, empty for any verb
+	Method string
+
+	// Path is the prefix of the path of the requests that the
+	// fault applies to. If it's empty the fault applies to all
+	// the requests, except the authentication and refresh ones.
+	Path string
+
+	// After is the number of matching requests that are
+	// served normally before the fault applies
+	After int
+
+	// Times is how many times the fault applies,
+	// 0 if it applies forever
+	Times int
+
+	// Status if it's not 0 is the status of the error
+	// response that is written instead of the normal one
+	Status int
+
+	// Message is the message of the error response
+	Message string
+
+	// Reference is the reference id of the error response,
+	// like the ones the api returns with the 5xx errors
+	Reference string
+
+	// Delay delays the response
+	Delay time.Duration
+
+	// Drop closes the connection without writing any response.
+	// If the connection can't be taken over the response is 502.
+	Drop bool
+
+	// ExpireSession invalidates the session cookie, so this and all
+	// the requests that follow are answered with 401 Unauthorized
+	// until the client authenticates again
+	ExpireSession bool
+
+	// seen is the number of matching requests
+	seen int
+	// applied is the number of times the fault applied
+	applied int
+}
+
+// ExpireSessionAfter returns the fault that expires
+// the session cookie after n requests
+func ExpireSessionAfter(n int) Fault {
+	return Fault{After: n, Times: 1, ExpireSession: true}
+}
+
+// ConflictOnDelete returns the fault that answers the delete
+// requests on path with 409 Conflict, like the api does when
+// the resource is still in use
+func ConflictOnDelete(path string) Fault {
+	return Fault{
+		Method:  "DELETE",
+		Path:    path,
+		Status:  http.StatusConflict,
+		Message: "Conflict: the object is in use",
+	}
+}
+
+// InternalError returns the fault that answers the requests
+// on path with 500 and the reference id
+func InternalError(method, path, reference string) Fault {
+	return Fault{
+		Method:    method,
+		Path:      path,
+		Status:    http.StatusInternalServerError,
+		Message:   "Internal server error",
+		Reference: reference,
+	}
+}
+
+// Inject adds the faults to the server. When a request matches
+// more than one fault the one that was injected first applies.
+func (s *Server) Inject(faults ...Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range faults {
+		f := faults[i]
+		f.seen, f.applied = 0, 0
+		s.faults = append(s.faults, &f)
+	}
+}
+
+// ClearFaults removes all the injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// fault returns a copy of the fault that applies to the request,
+// or nil. It must be called with the lock held.
+func (s *Server) fault(r *http.Request) *Fault {
+	var fault *Fault
+	for _, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+
+		f.seen++
+		if fault != nil || f.seen <= f.After {
+			continue
+		}
+		if f.Times != 0 && f.applied >= f.Times {
+			continue
+		}
+
+		f.applied++
+		c := *f
+		fault = &c
+	}
+
+	if fault != nil && fault.ExpireSession {
+		s.session = ""
+	}
+
+	return fault
+}
+
+// matches returns true if the fault applies to the request
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+
+	if f.Path == "" {
+		return r.URL.Path != "/authenticate/" && r.URL.Path != "/refresh/"
+	}
+
+	return strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// misbehave applies the fault and returns true if the
+// response was written or the connection was dropped
+func (f *Fault) misbehave(w http.ResponseWriter) bool {
+	if f.Delay > 0 {
+		time.Sleep(f.Delay)
+	}
+
+	if f.Drop {
+		if hj, ok := w.(http.Hijacker); ok {
+			if conn, _, err := hj.Hijack(); err == nil {
+				conn.Close()
+				return true
+			}
+		}
+		// without a connection to close answer with a
+		// gateway error, like a proxy that lost the backend
+		writeError(w, http.StatusBadGateway, "Connection dropped")
+		return true
+	}
+
+	status := f.Status
+	if status == 0 && f.ExpireSession {
+		status = http.StatusUnauthorized
+	}
+
+	if status == 0 {
+		return false
+	}
+
+	message := f.Message
+	if message == "" {
+		message = http.StatusText(status)
+	}
+
+	body := map[string]string{"message": message}
+	if f.Reference != "" {
+		body["reference"] = f.Reference
+	}
+
+	writeJSON(w, status, body)
+	return true
+}
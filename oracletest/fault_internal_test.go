@@ -0,0 +1,25 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	gc "gopkg.in/check.v1"
+)
+
+type dropTest struct{}
+
+var _ = gc.Suite(&dropTest{})
+
+func (d dropTest) TestDropWithoutHijacker(c *gc.C) {
+	// the recorder can't be hijacked
+	w := httptest.NewRecorder()
+
+	f := Fault{Drop: true}
+	c.Assert(f.misbehave(w), gc.Equals, true)
+	c.Assert(w.Code, gc.Equals, http.StatusBadGateway)
+	c.Assert(w.Body.String(), gc.Equals, `{"message":"Connection dropped"}`+"\n")
+}
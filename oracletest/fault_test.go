@@ -0,0 +1,92 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest_test
+
+import (
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+type faultTest struct {
+	server *oracletest.Server
+	cli    *api.Client
+}
+
+var _ = gc.Suite(&faultTest{})
+
+func (f *faultTest) SetUpTest(c *gc.C) {
+	f.server = oracletest.NewServer("myIdentify", "user", "secret")
+
+	var err error
+	f.cli, err = api.NewClient(f.server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(f.cli.Authenticate(), gc.IsNil)
+}
+
+func (f *faultTest) TearDownTest(c *gc.C) {
+	f.server.Close()
+}
+
+func (f *faultTest) TestExpireSession(c *gc.C) {
+	f.server.Inject(oracletest.ExpireSessionAfter(2))
+
+	for i := 0; i < 2; i++ {
+		_, err := f.cli.AllSSHKeyDetails()
+		c.Assert(err, gc.IsNil)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := f.cli.AllSSHKeyDetails()
+		c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 401 Unauthorized")
+	}
+
+	c.Assert(f.cli.RefreshCookie(), gc.ErrorMatches,
+		"go-oracle-cloud: Error api response 401 Unauthorized")
+}
+
+func (f *faultTest) TestConflictOnDelete(c *gc.C) {
+	_, err := f.cli.CreateVnicSet("web", "", nil, nil, nil)
+	c.Assert(err, gc.IsNil)
+
+	f.server.Inject(oracletest.ConflictOnDelete("/network/v1/vnicset/"))
+
+	err = f.cli.DeleteVnicSet("web")
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 409 Conflict: the object is in use")
+
+	f.server.ClearFaults()
+	c.Assert(f.cli.DeleteVnicSet("web"), gc.IsNil)
+}
+
+func (f *faultTest) TestInternalErrorReference(c *gc.C) {
+	f.server.Inject(oracletest.InternalError("POST", "/launchplan/", "ref-42"))
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	_, err = f.cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.ErrorMatches,
+		"go-oracle-cloud: Error Api response 500 Internal server error Reference : ref-42")
+}
+
+func (f *faultTest) TestDelayAndDrop(c *gc.C) {
+	f.server.Inject(
+		oracletest.Fault{Method: "GET", Path: "/shape/", Times: 1, Delay: 50 * time.Millisecond},
+		oracletest.Fault{Method: "GET", Path: "/seclist/", Drop: true},
+	)
+
+	start := time.Now()
+	_, err := f.cli.AllShapeDetails()
+	c.Assert(err, gc.IsNil)
+	c.Assert(time.Since(start) >= 50*time.Millisecond, gc.Equals, true)
+
+	_, err = f.cli.AllSecList()
+	c.Assert(err, gc.NotNil)
+}
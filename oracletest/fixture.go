@@ -0,0 +1,320 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Scrubbed replaces the passwords and the session
+// cookies that are written in the fixture files
+const Scrubbed = "scrubbed"
+
+// Interaction is a recorded request and response pair
+type Interaction struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest is a recorded request
+type FixtureRequest struct {
+	// Method is the http verb of the request
+	Method string `json:"method"`
+
+	// Path is the path and the query of the request url.
+	// The host is not recorded so the fixtures could be
+	// replayed against any endpoint.
+	Path string `json:"path"`
+
+	// Body is the json body of the request, if any
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// FixtureResponse is a recorded response
+type FixtureResponse struct {
+	// Status is the http status code of the response
+	Status int `json:"status"`
+
+	// Header holds the Content-Type and the
+	// Set-Cookie headers of the response
+	Header http.Header `json:"header,omitempty"`
+
+	// Body is the json body of the response, if any
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// Text is the body of the response if it's not json
+	Text string `json:"text,omitempty"`
+}
+
+// Fixture holds all the recorded interactions
+// in the order they were made
+type Fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// RecordingTransport is an http.RoundTripper that makes the requests
+// with its Transport and records them together with their responses.
+// Use Save to write the recorded interactions to a fixture file.
+type RecordingTransport struct {
+	// Transport makes the real requests. If it's nil
+	// the http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+// NewRecordingTransport returns a recording transport
+// that makes the requests with transport
+func NewRecordingTransport(transport http.RoundTripper) *RecordingTransport {
+	return &RecordingTransport{Transport: transport}
+}
+
+// RoundTrip makes the request and records the interaction
+func (r *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, req, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+
+	interaction := Interaction{
+		Request: FixtureRequest{
+			Method: req.Method,
+			Path:   req.URL.RequestURI(),
+			Body:   scrubBody(body),
+		},
+		Response: FixtureResponse{
+			Status: resp.StatusCode,
+			Header: scrubHeader(resp.Header),
+		},
+	}
+
+	if len(raw) != 0 {
+		// a valid json body is kept as it is
+		var body json.RawMessage
+		if json.Unmarshal(raw, &body) == nil {
+			interaction.Response.Body = body
+		} else {
+			interaction.Response.Text = string(raw)
+		}
+	}
+
+	r.mu.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Save writes all the recorded interactions to the fixture file
+func (r *RecordingTransport) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	raw, err := json.MarshalIndent(r.fixture, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0644)
+}
+
+// ReplayTransport is an http.RoundTripper that answers the requests
+// with the responses of a fixture, without any network access.
+// A request is matched on its verb, path and json body. If the same
+// request was recorded more than once the responses are served in
+// the recorded order and the last one is served from then on.
+type ReplayTransport struct {
+	mu     sync.Mutex
+	served map[string]int
+	index  map[string][]Interaction
+}
+
+// NewReplayTransport returns a replay transport
+// that serves the interactions of the fixture
+func NewReplayTransport(fixture Fixture) *ReplayTransport {
+	r := &ReplayTransport{
+		served: make(map[string]int),
+		index:  make(map[string][]Interaction),
+	}
+
+	for _, interaction := range fixture.Interactions {
+		// the body is canonicalized again because the
+		// fixture files are indented when they are saved
+		key := matchKey(interaction.Request.Method,
+			interaction.Request.Path, scrubBody(interaction.Request.Body))
+		r.index[key] = append(r.index[key], interaction)
+	}
+
+	return r
+}
+
+// LoadReplayTransport returns a replay transport that serves
+// the interactions of the fixture file
+func LoadReplayTransport(path string) (*ReplayTransport, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture Fixture
+	if err = json.Unmarshal(raw, &fixture); err != nil {
+		return nil, fmt.Errorf("oracletest: Invalid fixture file %s: %v", path, err)
+	}
+
+	return NewReplayTransport(fixture), nil
+}
+
+// RoundTrip answers the request with the recorded response
+func (r *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, req, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := matchKey(req.Method, req.URL.RequestURI(), scrubBody(body))
+
+	r.mu.Lock()
+	interactions := r.index[key]
+	n := r.served[key]
+	if n < len(interactions)-1 {
+		r.served[key]++
+	}
+	r.mu.Unlock()
+
+	if len(interactions) == 0 {
+		return nil, fmt.Errorf(
+			"oracletest: No recorded response for %s %s",
+			req.Method, req.URL.RequestURI(),
+		)
+	}
+
+	recorded := interactions[n].Response
+	raw := []byte(recorded.Body)
+	if recorded.Text != "" {
+		raw = []byte(recorded.Text)
+	}
+
+	header := make(http.Header)
+	for k, v := range recorded.Header {
+		header[k] = append([]string(nil), v...)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(raw)),
+		ContentLength: int64(len(raw)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody reads the body of the request and returns it
+// together with a request whose body could be read again
+func readRequestBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil {
+		return nil, req, nil
+	}
+
+	raw, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clone := *req
+	clone.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	return raw, &clone, nil
+}
+
+// scrubBody replaces the password fields of the json body.
+// The body is returned in its canonical form, with the
+// object keys sorted, so it could be used for matching.
+func scrubBody(raw []byte) json.RawMessage {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil
+	}
+
+	scrubPasswords(body)
+
+	canonical, _ := json.Marshal(body)
+	return canonical
+}
+
+// scrubPasswords replaces all the password
+// fields of the json value recursively
+func scrubPasswords(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if key == "password" {
+				v[key] = Scrubbed
+				continue
+			}
+			scrubPasswords(field)
+		}
+	case []interface{}:
+		for _, field := range v {
+			scrubPasswords(field)
+		}
+	}
+}
+
+// scrubHeader returns the headers of the response that are
+// recorded, with the value of the session cookies scrubbed
+func scrubHeader(h http.Header) http.Header {
+	header := make(http.Header)
+
+	if ct := h.Get("Content-Type"); ct != "" {
+		header.Set("Content-Type", ct)
+	}
+
+	resp := http.Response{Header: h}
+	for _, cookie := range resp.Cookies() {
+		cookie.Value = Scrubbed
+		header.Add("Set-Cookie", cookie.String())
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+
+	return header
+}
+
+// matchKey returns the key on which the requests are matched
+func matchKey(method, path string, body json.RawMessage) string {
+	return method + " " + path + " " + string(body)
+}
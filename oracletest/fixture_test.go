@@ -0,0 +1,66 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type fixtureTest struct{}
+
+var _ = gc.Suite(&fixtureTest{})
+
+// sshKeys runs the interactions that are recorded and replayed
+func sshKeys(c *gc.C, cfg api.Config) response.AllSSH {
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	_, err = cli.AddSHHKey("juju", "ssh-rsa AAAA", true)
+	c.Assert(err, gc.IsNil)
+
+	keys, err := cli.AllSSHKeyDetails()
+	c.Assert(err, gc.IsNil)
+
+	return keys
+}
+
+func (f fixtureTest) TestRecordAndReplay(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "sshkeys.json")
+
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	recorder := oracletest.NewRecordingTransport(nil)
+	cfg := server.Config()
+	cfg.Transport = recorder
+
+	recorded := sshKeys(c, cfg)
+	c.Assert(recorded.Result, gc.HasLen, 1)
+	c.Assert(recorder.Save(path), gc.IsNil)
+	server.Close()
+
+	raw, err := ioutil.ReadFile(path)
+	c.Assert(err, gc.IsNil)
+	c.Assert(strings.Contains(string(raw), "secret"), gc.Equals, false)
+	c.Assert(strings.Contains(string(raw), "session-"), gc.Equals, false)
+
+	replayer, err := oracletest.LoadReplayTransport(path)
+	c.Assert(err, gc.IsNil)
+
+	cfg.Transport = replayer
+	replayed := sshKeys(c, cfg)
+	c.Assert(replayed, gc.DeepEquals, recorded)
+
+	cli, err := api.NewClient(cfg)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+	_, err = cli.AllSecList()
+	c.Assert(err, gc.ErrorMatches, ".*oracletest: No recorded response for GET /seclist/.*")
+}
@@ -0,0 +1,179 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the time source of the fake server
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+}
+
+// realClock is the clock of the system
+type realClock struct{}
+
+// Now returns the current time of the system
+func (realClock) Now() time.Time { return time.Now() }
+
+// ManualClock is a clock that moves only when it's advanced,
+// so the lifecycles of the resources could be tested
+// deterministically
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock returns a manual clock set at the given time
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the current time of the clock
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves the clock forward
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Lifecycle holds how long the resources stay in their
+// transient states. If a duration is 0 the resources go
+// straight to their final state.
+type Lifecycle struct {
+	// InstanceStarting is how long the instances
+	// stay starting before they are running
+	InstanceStarting time.Duration
+
+	// RebootQueued is how long the reboot instance
+	// requests stay queued before they are complete
+	RebootQueued time.Duration
+
+	// VolumeInitializing is how long the storage volumes
+	// stay Initializing before they are Online
+	VolumeInitializing time.Duration
+}
+
+// object identifies an object of the fake server by its kind and
+// name, since objects of different kinds could have the same name
+type object struct {
+	kind string
+	name string
+}
+
+// transition is a pending change of the state of an object
+type transition struct {
+	field string
+	value string
+	at    time.Time
+}
+
+// SetClock sets the clock that the server uses
+// for the lifecycles of the resources
+func (s *Server) SetClock(clock Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clock = clock
+}
+
+// SetLifecycle sets how long the resources that are
+// created from now on stay in their transient states
+func (s *Server) SetLifecycle(lifecycle Lifecycle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lifecycle = lifecycle
+}
+
+// begin sets the initial state of a new object and schedules its
+// final state. It must be called with the lock held.
+func (s *Server) begin(kind, name string, o map[string]interface{}) {
+	now := s.clock.Now()
+
+	switch kind {
+	case "instance":
+		final := "running"
+		if o["desired_state"] == "shutdown" {
+			final = "shutdown"
+		}
+		o["start_time"] = now.UTC().Format(time.RFC3339)
+		s.schedule(kind, name, o, "state", "starting", final,
+			now.Add(s.lifecycle.InstanceStarting))
+	case "rebootinstancerequest":
+		o["creation_time"] = now.UTC().Format(time.RFC3339)
+		s.schedule(kind, name, o, "state", "queued", "complete",
+			now.Add(s.lifecycle.RebootQueued))
+	case "storage/volume":
+		s.schedule(kind, name, o, "status", "Initializing", "Online",
+			now.Add(s.lifecycle.VolumeInitializing))
+	}
+}
+
+// schedule sets the field of the object to the initial value
+// and to the final value when the clock reaches at
+func (s *Server) schedule(
+	kind, name string,
+	o map[string]interface{},
+	field, initial, final string,
+	at time.Time,
+) {
+	if !at.After(s.clock.Now()) {
+		o[field] = final
+		return
+	}
+
+	o[field] = initial
+	if s.transitions == nil {
+		s.transitions = make(map[object]transition)
+	}
+	s.transitions[object{kind, name}] = transition{
+		field: field,
+		value: final,
+		at:    at,
+	}
+}
+
+// rename moves the pending transition of the object to its new
+// name, or drops it if the new name is empty because the object
+// was deleted. It must be called with the lock held.
+func (s *Server) rename(kind, name, newName string) {
+	t, ok := s.transitions[object{kind, name}]
+	if !ok {
+		return
+	}
+
+	delete(s.transitions, object{kind, name})
+	if newName != "" {
+		s.transitions[object{kind, newName}] = t
+	}
+}
+
+// advance applies all the transitions that are due.
+// It must be called with the lock held.
+func (s *Server) advance() {
+	now := s.clock.Now()
+
+	for key, t := range s.transitions {
+		if t.at.After(now) {
+			continue
+		}
+
+		if o, ok := s.store[key.kind][key.name]; ok {
+			o[t.field] = t.value
+		}
+		delete(s.transitions, key)
+	}
+}
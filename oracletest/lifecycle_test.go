@@ -0,0 +1,136 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type lifecycleTest struct{}
+
+var _ = gc.Suite(&lifecycleTest{})
+
+func (l lifecycleTest) TestLifecycle(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	clock := oracletest.NewManualClock(time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC))
+	server.SetClock(clock)
+	server.SetLifecycle(oracletest.Lifecycle{
+		InstanceStarting:   2 * time.Minute,
+		RebootQueued:       time.Minute,
+		VolumeInitializing: 30 * time.Second,
+	})
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	volume, err := cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "data",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(volume.Status, gc.Equals, response.VolumeInitializing)
+
+	instance, err := api.NewInstanceBuilder("web", "web", "oc3").
+		Imagelist("/oracle/public/OL_7.2_UEKR4_x86_64").
+		Build()
+	c.Assert(err, gc.IsNil)
+
+	plan, err := cli.CreateInstance(api.InstanceParams{
+		Instances: []api.Instances{instance},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(plan.Instances[0].State, gc.Equals, response.InstanceStarting)
+	c.Assert(plan.Instances[0].Start_time.Equal(clock.Now()), gc.Equals, true)
+
+	all, err := cli.AllInstances()
+	c.Assert(err, gc.IsNil)
+	name := all.Result[0].Name
+
+	clock.Advance(30 * time.Second)
+
+	volume, err = cli.StorageVolumeDetails("data")
+	c.Assert(err, gc.IsNil)
+	c.Assert(volume.Status, gc.Equals, response.VolumeOnline)
+
+	details, err := cli.InstanceDetails(name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(details.State, gc.Equals, response.InstanceStarting)
+
+	clock.Advance(90 * time.Second)
+
+	details, err = cli.InstanceDetails(name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(details.State, gc.Equals, response.InstanceRunning)
+
+	reboot, err := cli.CreateRebootInstanceRequest(false, name)
+	c.Assert(err, gc.IsNil)
+	c.Assert(reboot.State, gc.Equals, "queued")
+
+	clock.Advance(time.Minute)
+
+	// any request advances the lifecycles
+	_, err = cli.InstanceDetails(name)
+	c.Assert(err, gc.IsNil)
+
+	names := server.Names("rebootinstancerequest")
+	c.Assert(names, gc.HasLen, 1)
+
+	request, ok := server.Object("rebootinstancerequest", names[0])
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(request["state"], gc.Equals, "complete")
+	c.Assert(request["instance"], gc.Equals,
+		fmt.Sprintf("/Compute-%s/%s/%s", server.Identify, server.Username, name))
+}
+
+func (l lifecycleTest) TestRecreate(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	clock := oracletest.NewManualClock(time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC))
+	server.SetClock(clock)
+	server.SetLifecycle(oracletest.Lifecycle{VolumeInitializing: 10 * time.Second})
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	params := api.StorageVolumeParams{
+		Name:       "data",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	}
+
+	_, err = cli.CreateStorageVolume(params)
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.DeleteStorageVolume("data"), gc.IsNil)
+
+	// the volume created again with the same name
+	// doesn't take the state of the deleted one
+	server.SetLifecycle(oracletest.Lifecycle{VolumeInitializing: time.Minute})
+	volume, err := cli.CreateStorageVolume(params)
+	c.Assert(err, gc.IsNil)
+	c.Assert(volume.Status, gc.Equals, response.VolumeInitializing)
+
+	clock.Advance(30 * time.Second)
+
+	volume, err = cli.StorageVolumeDetails("data")
+	c.Assert(err, gc.IsNil)
+	c.Assert(volume.Status, gc.Equals, response.VolumeInitializing)
+
+	clock.Advance(30 * time.Second)
+
+	volume, err = cli.StorageVolumeDetails("data")
+	c.Assert(err, gc.IsNil)
+	c.Assert(volume.Status, gc.Equals, response.VolumeOnline)
+}
@@ -0,0 +1,418 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// kinds are the resource kinds that the fake server knows,
+// the requests for any other kind are answered with 404
+var kinds = map[string]bool{
+	"account":                        true,
+	"backupservice/v1/configuration": true,
+	"imagelist":                      true,
+	"instance":                       true,
+	"instanceconsole":                true,
+	"ip/association":                 true,
+	"ip/reservation":                 true,
+	"launchplan":                     true,
+	"network/v1/acl":                 true,
+	"network/v1/ipaddressprefixset":  true,
+	"network/v1/ipassociation":       true,
+	"network/v1/ipnetwork":           true,
+	"network/v1/ipnetworkexchange":   true,
+	"network/v1/ipreservation":       true,
+	"network/v1/route":               true,
+	"network/v1/secprotocol":         true,
+	"network/v1/secrule":             true,
+	"network/v1/vnic":                true,
+	"network/v1/vnicset":             true,
+	"property/storage":               true,
+	"rebootinstancerequest":          true,
+	"secapplication":                 true,
+	"secassociation":                 true,
+	"seciplist":                      true,
+	"seclist":                        true,
+	"shape":                          true,
+	"sshkey":                         true,
+	"storage/volume":                 true,
+}
+
+// aliases are the kinds that the api exposes
+// under more than one path
+var aliases = map[string]string{
+	"ip/ipassociation": "ip/association",
+}
+
+// flat are the kinds whose objects are not in a container
+var flat = map[string]bool{
+	"shape": true,
+}
+
+// plain are the kinds whose list is a plain json
+// array instead of an object with a result field
+var plain = map[string]bool{
+	"backupservice/v1/configuration": true,
+}
+
+// resource is the parsed path of a request
+type resource struct {
+	// kind is the kind of the resource, like instance
+	kind string
+	// name is the name of the object or of the container
+	// if it ends with /, empty if the request is for the
+	// whole kind
+	name string
+}
+
+// parse splits the path in the kind and the name of the resource.
+// The name starts with the first Compute-<identify> or oracle
+// path element.
+func parse(path string) resource {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, part := range parts {
+		if strings.HasPrefix(part, "Compute-") || part == "oracle" {
+			r := resource{
+				kind: strings.Join(parts[:i], "/"),
+				name: "/" + strings.Join(parts[i:], "/"),
+			}
+			if strings.HasSuffix(path, "/") {
+				r.name += "/"
+			}
+			return r
+		}
+	}
+
+	if len(parts) > 1 && flat[parts[0]] {
+		return resource{
+			kind: parts[0],
+			name: "/" + strings.Join(parts[1:], "/"),
+		}
+	}
+
+	return resource{kind: strings.Join(parts, "/")}
+}
+
+// route dispatches the request to the handler of the resource
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	res := parse(r.URL.Path)
+	if alias, ok := aliases[res.kind]; ok {
+		res.kind = alias
+	}
+
+	if !kinds[res.kind] {
+		writeError(w, http.StatusNotFound,
+			fmt.Sprintf("Unknown resource %s", r.URL.Path))
+		return
+	}
+
+	switch {
+	case res.kind == "launchplan" && res.name == "" && r.Method == "POST":
+		s.launchPlan(w, r)
+	case res.kind == "imagelist" && strings.Contains(res.name, "/entry/"):
+		s.imageListEntry(w, r, res)
+	case res.name == "":
+		switch r.Method {
+		case "GET":
+			s.listKind(w, res)
+		case "POST":
+			s.create(w, r, res)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+	case strings.HasSuffix(res.name, "/"):
+		switch r.Method {
+		case "GET":
+			s.listContainer(w, r, res)
+		case "POST":
+			s.create(w, r, res)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+	default:
+		switch r.Method {
+		case "GET":
+			s.details(w, res)
+		case "PUT":
+			s.update(w, r, res)
+		case "DELETE":
+			s.remove(w, res)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+	}
+}
+
+// decode decodes the json object of the request body
+func decode(r *http.Request) (map[string]interface{}, error) {
+	var o map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, fmt.Errorf("oracletest: Empty request body")
+	}
+	return o, nil
+}
+
+// listKind lists all the objects of the kind
+func (s *Server) listKind(w http.ResponseWriter, res resource) {
+	objects := s.store.list(res.kind, "")
+	if plain[res.kind] {
+		writeJSON(w, http.StatusOK, objects)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"result": objects})
+}
+
+// listContainer lists the objects of the container, or only their
+// names if the request accepts directory responses
+func (s *Server) listContainer(w http.ResponseWriter, r *http.Request, res resource) {
+	if strings.Contains(r.Header.Get("Accept"), "+directory") {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"result": s.store.directory(res.kind, res.name),
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"result": s.store.list(res.kind, res.name),
+	})
+}
+
+// create creates the object from the request body. If the object has
+// no name or its name is a container, an unique name is generated.
+func (s *Server) create(w http.ResponseWriter, r *http.Request, res resource) {
+	o, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := o["name"].(string)
+	switch {
+	case name == "":
+		name = fmt.Sprintf("/Compute-%s/%s/%s", s.Identify, s.Username, s.uuid())
+	case strings.HasSuffix(name, "/"):
+		name += s.uuid()
+	}
+	name = key(name)
+
+	if _, ok := s.store.get(res.kind, name); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf(
+			"Conflict: %s %s already exists", res.kind, name,
+		))
+		return
+	}
+
+	if res.kind == "rebootinstancerequest" {
+		// the name of the request is the name of the instance
+		// followed by the generated id of the request
+		o["instance"] = name[:strings.LastIndex(name, "/")]
+	}
+
+	o["name"] = name
+	o["uri"] = s.uri(res.kind, name)
+	s.begin(res.kind, name, o)
+	s.store.put(res.kind, name, o)
+
+	writeJSON(w, http.StatusCreated, o)
+}
+
+// details returns the object
+func (s *Server) details(w http.ResponseWriter, res resource) {
+	o, ok := s.store.get(res.kind, res.name)
+	if !ok {
+		writeNotFound(w, res)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, o)
+}
+
+// update merges the request body into the object
+// renaming it if the body has a different name
+func (s *Server) update(w http.ResponseWriter, r *http.Request, res resource) {
+	o, ok := s.store.get(res.kind, res.name)
+	if !ok {
+		writeNotFound(w, res)
+		return
+	}
+
+	body, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for k, v := range body {
+		o[k] = v
+	}
+
+	name, _ := o["name"].(string)
+	name = key(name)
+	if name != res.name {
+		if _, ok := s.store.get(res.kind, name); ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf(
+				"Conflict: %s %s already exists", res.kind, name,
+			))
+			return
+		}
+		s.store.remove(res.kind, res.name)
+		s.rename(res.kind, res.name, name)
+	}
+
+	o["name"] = name
+	o["uri"] = s.uri(res.kind, name)
+	s.store.put(res.kind, name, o)
+
+	writeJSON(w, http.StatusOK, o)
+}
+
+// remove deletes the object
+func (s *Server) remove(w http.ResponseWriter, res resource) {
+	if !s.store.remove(res.kind, res.name) {
+		writeNotFound(w, res)
+		return
+	}
+	s.rename(res.kind, res.name, "")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// launchPlan creates the instances of the launch plan
+func (s *Server) launchPlan(w http.ResponseWriter, r *http.Request) {
+	var plan struct {
+		Relationships []interface{}            `json:"relationships,omitempty"`
+		Instances     []map[string]interface{} `json:"instances"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&plan); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(plan.Instances) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"message": map[string]string{"instances": "No instances given"},
+		})
+		return
+	}
+
+	for _, o := range plan.Instances {
+		id := s.uuid()
+		name, _ := o["name"].(string)
+		name = strings.TrimSuffix(key(name), "/") + "/" + id
+
+		o["name"] = name
+		o["id"] = id
+		o["uri"] = s.uri("instance", name)
+		s.begin("instance", name, o)
+		s.store.put("instance", name, o)
+	}
+
+	writeJSON(w, http.StatusCreated, plan)
+}
+
+// imageListEntry handles the requests for the
+// entries of an image list
+func (s *Server) imageListEntry(w http.ResponseWriter, r *http.Request, res resource) {
+	i := strings.Index(res.name, "/entry/")
+	name, version := res.name[:i], res.name[i+len("/entry/"):]
+
+	list, ok := s.store.get(res.kind, name)
+	if !ok {
+		writeNotFound(w, resource{kind: res.kind, name: name})
+		return
+	}
+
+	entries, _ := list["entries"].([]interface{})
+
+	find := func(v int) int {
+		for i, entry := range entries {
+			e, _ := entry.(map[string]interface{})
+			if n, _ := e["version"].(float64); int(n) == v {
+				return i
+			}
+		}
+		return -1
+	}
+
+	if version == "" {
+		if r.Method != "POST" {
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+
+		body, err := decode(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		v, _ := body["version"].(float64)
+		if find(int(v)) != -1 {
+			writeError(w, http.StatusConflict, fmt.Sprintf(
+				"Conflict: entry %d of image list %s already exists", int(v), name,
+			))
+			return
+		}
+
+		entry := map[string]interface{}{
+			"attributes":    body["attributes"],
+			"imagelist":     name,
+			"version":       v,
+			"machineimages": body["machineImages"],
+			"uri":           fmt.Sprintf("%s/entry/%d", s.uri(res.kind, name), int(v)),
+		}
+
+		list["entries"] = append(entries, entry)
+		s.store.put(res.kind, name, list)
+
+		// the api returns the whole image list
+		// instead of its name in this response
+		add := clone(entry)
+		delete(add, "imagelist")
+		add["Imagelist"] = list
+		writeJSON(w, http.StatusCreated, add)
+		return
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid image list entry version")
+		return
+	}
+
+	i = find(v)
+	if i == -1 {
+		writeNotFound(w, res)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, entries[i])
+	case "DELETE":
+		list["entries"] = append(entries[:i], entries[i+1:]...)
+		s.store.put(res.kind, name, list)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// writeNotFound writes the not found error of the resource
+func writeNotFound(w http.ResponseWriter, res resource) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf(
+		"%s %s does not exist", res.kind, res.name,
+	))
+}
@@ -0,0 +1,315 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oracletest provides an in-memory fake of the oracle compute
+// cloud api, based on httptest, so the client and the code that uses
+// it can run integration tests offline.
+//
+// The fake keeps every resource as a json object in a generic store
+// indexed by the kind of the resource, like instance, seclist or
+// network/v1/ipnetwork, and by the multipart name of the resource.
+// It implements the authentication and the refresh of the session
+// cookie, the create, details, list, update and delete requests,
+// directory listings and the few endpoints that don't follow the
+// generic rules, like the launch plans and the image list entries.
+package oracletest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+)
+
+// CookieName is the name of the session cookie
+const CookieName = "nimbula"
+
+// Server is a fake oracle compute cloud api server
+type Server struct {
+	*httptest.Server
+
+	// Identify is the identity domain of the account
+	Identify string
+
+	// Username is the username of the account
+	Username string
+
+	// Password is the password of the account
+	Password string
+
+	mu      sync.Mutex
+	store   store
+	session string
+	counter uint64
+	faults  []*Fault
+
+	clock       Clock
+	lifecycle   Lifecycle
+	transitions map[object]transition
+}
+
+// NewServer starts and returns a new fake server with an account for
+// the given credentials. The server is populated with the shapes and
+// the storage properties that a site usually provides.
+// The caller should call Close when finished, to shut it down.
+func NewServer(identify, username, password string) *Server {
+	s := &Server{
+		Identify: identify,
+		Username: username,
+		Password: password,
+		store:    make(store),
+		clock:    realClock{},
+	}
+
+	s.seed()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Config returns a client config that points to the fake server
+func (s *Server) Config() api.Config {
+	return api.Config{
+		Identify: s.Identify,
+		Username: s.Username,
+		Password: s.Password,
+		Endpoint: s.URL,
+	}
+}
+
+// Put adds or replaces the object of the given kind in the store.
+// The kind must be one that the server knows and the object
+// must be json encodable and must have a name.
+func (s *Server) Put(kind string, object interface{}) error {
+	if !kinds[kind] {
+		return fmt.Errorf("oracletest: Unknown resource kind %s", kind)
+	}
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	var o map[string]interface{}
+	if err = json.Unmarshal(raw, &o); err != nil {
+		return err
+	}
+
+	name, _ := o["name"].(string)
+	if name == "" {
+		return fmt.Errorf("oracletest: Object of kind %s has no name", kind)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o["uri"] = s.uri(kind, name)
+	s.store.put(kind, key(name), o)
+
+	return nil
+}
+
+// Object returns the object of the given kind with the given name
+func (s *Server) Object(kind, name string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.get(kind, key(name))
+}
+
+// Names returns the names of all the objects of the given kind
+func (s *Server) Names(kind string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.names(kind)
+}
+
+// seed populates the store with the
+// resources that the site provides
+func (s *Server) seed() {
+	shapes := []struct {
+		name string
+		cpus float64
+		ram  uint64
+		gpus uint64
+		ssd  uint64
+		root uint64
+	}{
+		{"oc3", 1, 7680, 0, 0, 0},
+		{"oc4", 2, 15360, 0, 0, 0},
+		{"oc5", 4, 30720, 0, 0, 0},
+		{"oc6", 8, 61440, 0, 0, 0},
+		{"oc1m", 1, 15360, 0, 0, 0},
+		{"oc2m", 2, 30720, 0, 0, 0},
+		{"ocio1m", 1, 15360, 0, 400 << 30, 20 << 30},
+		{"ocsg1-k80", 4, 61440, 1, 0, 0},
+	}
+
+	for _, shape := range shapes {
+		s.store.put("shape", key(shape.name), map[string]interface{}{
+			"name":           shape.name,
+			"cpus":           shape.cpus,
+			"ram":            shape.ram,
+			"gpus":           shape.gpus,
+			"ssd_data_size":  shape.ssd,
+			"root_disk_size": shape.root,
+			"is_root_ssd":    shape.root != 0,
+			"io":             200,
+			"nds_iops_limit": 0,
+			"uri":            "/shape/" + shape.name,
+		})
+	}
+
+	properties := map[string]string{
+		"default": "Default storage property",
+		"latency": "Low latency storage property",
+		"ssd/gpl": "SSD general purpose storage property",
+	}
+
+	for name, description := range properties {
+		name = "/oracle/public/storage/" + name
+		s.store.put("property/storage", name, map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"uri":         "/property/storage" + name,
+		})
+	}
+}
+
+// serveHTTP authenticates and routes all the requests
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	fault := s.fault(r)
+	s.mu.Unlock()
+
+	// the fault is applied without the lock held
+	// so a delayed response doesn't block the others
+	if fault != nil && fault.misbehave(w) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+
+	switch r.URL.Path {
+	case "/authenticate/":
+		s.authenticate(w, r)
+		return
+	case "/refresh/":
+		s.refresh(w, r)
+		return
+	}
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	s.route(w, r)
+}
+
+// authenticate handles the POST /authenticate/ request
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var auth struct {
+		User     string `json:"user"`
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&auth); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid authentication body")
+		return
+	}
+
+	user := fmt.Sprintf("/Compute-%s/%s", s.Identify, s.Username)
+	if auth.User != user || auth.Password != s.Password {
+		writeError(w, http.StatusUnauthorized, "Incorrect username or password")
+		return
+	}
+
+	s.newSession(w)
+}
+
+// refresh handles the GET /refresh/ request
+func (s *Server) refresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	s.newSession(w)
+}
+
+// newSession issues a new session cookie
+// invalidating the previous one
+func (s *Server) newSession(w http.ResponseWriter) {
+	s.session = fmt.Sprintf("session-%s", s.uuid())
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieName,
+		Value:    s.session,
+		Path:     "/",
+		Expires:  time.Now().Add(30 * time.Minute),
+		HttpOnly: true,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorized returns true if the request has a valid session cookie
+func (s *Server) authorized(r *http.Request) bool {
+	cookie, err := r.Cookie(CookieName)
+	if err != nil {
+		return false
+	}
+
+	return s.session != "" && cookie.Value == s.session
+}
+
+// uuid returns a new unique id in the format of an uuid
+func (s *Server) uuid() string {
+	s.counter++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.counter, s.counter)
+}
+
+// uri returns the uri of the object of the given kind
+func (s *Server) uri(kind, name string) string {
+	return fmt.Sprintf("%s/%s%s", s.URL, kind, key(name))
+}
+
+// key returns the name under which an object is stored
+func key(name string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	return "/" + name
+}
+
+// writeJSON writes the json encoded value with the given status
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/oracle-compute-v3+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes the error message in the format of the api
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
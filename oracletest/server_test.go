@@ -0,0 +1,135 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hoenirvili/go-oracle-cloud/api"
+	"github.com/hoenirvili/go-oracle-cloud/oracletest"
+	gc "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
+
+type serverTest struct{}
+
+var _ = gc.Suite(&serverTest{})
+
+func (s serverTest) TestUnauthorized(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/instance/Compute-myIdentify/user/")
+	c.Assert(err, gc.IsNil)
+	resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusUnauthorized)
+
+	body := strings.NewReader(`{"user":"/Compute-myIdentify/user","password":"secret"}`)
+	resp, err = http.Post(server.URL+"/authenticate/", "application/json", body)
+	c.Assert(err, gc.IsNil)
+	resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusNoContent)
+	c.Assert(resp.Cookies(), gc.HasLen, 1)
+	c.Assert(resp.Cookies()[0].Name, gc.Equals, oracletest.CookieName)
+}
+
+func (s serverTest) TestUnknownKind(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	body := strings.NewReader(`{"user":"/Compute-myIdentify/user","password":"secret"}`)
+	resp, err := http.Post(server.URL+"/authenticate/", "application/json", body)
+	c.Assert(err, gc.IsNil)
+	resp.Body.Close()
+	cookie := resp.Cookies()[0]
+
+	for path, status := range map[string]int{
+		"/instance/Compute-myIdentify/user/":         http.StatusOK,
+		"/instnace/Compute-myIdentify/user/":         http.StatusNotFound,
+		"/network/v1/ipnetwork/Compute-myIdentify/":  http.StatusOK,
+		"/network/v1/ipnetworks/Compute-myIdentify/": http.StatusNotFound,
+	} {
+		req, err := http.NewRequest("GET", server.URL+path, nil)
+		c.Assert(err, gc.IsNil)
+		req.AddCookie(cookie)
+
+		resp, err := http.DefaultClient.Do(req)
+		c.Assert(err, gc.IsNil)
+		resp.Body.Close()
+		c.Check(resp.StatusCode, gc.Equals, status, gc.Commentf("GET %s", path))
+	}
+
+	err = server.Put("instnace", map[string]string{"name": "web"})
+	c.Assert(err, gc.ErrorMatches, "oracletest: Unknown resource kind instnace")
+}
+
+func (s serverTest) TestNetworkResources(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	_, err = cli.CreateVnicSet("web", "web servers", nil, nil, nil)
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateVnicSet("web", "web servers", nil, nil, nil)
+	c.Assert(err, gc.ErrorMatches, "go-oracle-cloud: Error api response 409 .*")
+
+	set, err := cli.AddVnicToSet("web", "web1_eth0")
+	c.Assert(err, gc.IsNil)
+	c.Assert(set.Vnics, gc.DeepEquals, []string{"web1_eth0"})
+
+	_, err = cli.UpdateVnicSet("web", "frontend", "", set.Vnics, nil, nil)
+	c.Assert(err, gc.IsNil)
+
+	all, err := cli.AllVnicSet()
+	c.Assert(err, gc.IsNil)
+	c.Assert(all.Result, gc.HasLen, 1)
+	c.Assert(all.Result[0].Name, gc.Equals, "frontend")
+
+	c.Assert(server.Names("network/v1/vnicset"), gc.DeepEquals, []string{
+		"/Compute-myIdentify/user/frontend",
+	})
+
+	c.Assert(cli.DeleteVnicSet("frontend"), gc.IsNil)
+	c.Assert(cli.DeleteVnicSet("frontend"), gc.ErrorMatches,
+		"go-oracle-cloud: Error api response 404 .*")
+}
+
+func (s serverTest) TestSeed(c *gc.C) {
+	server := oracletest.NewServer("myIdentify", "user", "secret")
+	defer server.Close()
+
+	cli, err := api.NewClient(server.Config())
+	c.Assert(err, gc.IsNil)
+	c.Assert(cli.Authenticate(), gc.IsNil)
+
+	catalogue, err := cli.ShapeCatalogue()
+	c.Assert(err, gc.IsNil)
+	shape, err := catalogue.Smallest(api.ShapeRequirements{Gpus: 1})
+	c.Assert(err, gc.IsNil)
+	c.Assert(shape.Name, gc.Equals, "ocsg1-k80")
+
+	_, err = cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "data",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/default"},
+	})
+	c.Assert(err, gc.IsNil)
+
+	_, err = cli.CreateStorageVolume(api.StorageVolumeParams{
+		Name:       "fast",
+		Size:       "10G",
+		Properties: []string{"/oracle/public/storage/fast"},
+	})
+	c.Assert(err, gc.ErrorMatches,
+		`go-oracle-cloud: Storage property "/oracle/public/storage/fast" is not available.*`)
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oracletest
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// store holds all the objects of the fake server
+// indexed by their kind and by their name
+type store map[string]map[string]map[string]interface{}
+
+// put adds or replaces the object
+func (s store) put(kind, name string, o map[string]interface{}) {
+	if s[kind] == nil {
+		s[kind] = make(map[string]map[string]interface{})
+	}
+	s[kind][name] = o
+}
+
+// get returns a copy of the object
+func (s store) get(kind, name string) (map[string]interface{}, bool) {
+	o, ok := s[kind][name]
+	if !ok {
+		return nil, false
+	}
+	return clone(o), true
+}
+
+// remove deletes the object and returns true if it existed
+func (s store) remove(kind, name string) bool {
+	if _, ok := s[kind][name]; !ok {
+		return false
+	}
+	delete(s[kind], name)
+	return true
+}
+
+// names returns the sorted names of all the objects of the kind
+func (s store) names(kind string) []string {
+	names := make([]string, 0, len(s[kind]))
+	for name := range s[kind] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// list returns the objects of the kind that are
+// in the container, sorted by their names
+func (s store) list(kind, container string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+	for _, name := range s.names(kind) {
+		if strings.HasPrefix(name, container) {
+			objects = append(objects, clone(s[kind][name]))
+		}
+	}
+	return objects
+}
+
+// directory returns the names of the objects and of the
+// subcontainers that are directly in the container
+func (s store) directory(kind, container string) []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, name := range s.names(kind) {
+		if !strings.HasPrefix(name, container) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(name, container)
+		if i := strings.Index(rest, "/"); i != -1 {
+			rest = rest[:i+1]
+		}
+
+		if !seen[rest] {
+			seen[rest] = true
+			names = append(names, container+rest)
+		}
+	}
+	return names
+}
+
+// clone returns a deep copy of the object
+func clone(o map[string]interface{}) map[string]interface{} {
+	raw, _ := json.Marshal(o)
+	var c map[string]interface{}
+	json.Unmarshal(raw, &c)
+	return c
+}
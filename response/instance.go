@@ -3,6 +3,13 @@
 
 package response
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
 type LaunchPlan struct {
 	Relationships []string   `json:"relationships,omitempty"`
 	Instances     []Instance `json:"instances"`
@@ -19,6 +26,49 @@ type AllInstanceNames struct {
 type List struct {
 }
 
+// InstanceState is the state of an instance
+type InstanceState string
+
+const (
+	// InstanceQueued the instance is queued to be placed on a node
+	InstanceQueued InstanceState = "queued"
+
+	// InstanceInitializing the instance is being initialized
+	InstanceInitializing InstanceState = "initializing"
+
+	// InstancePreparing the storage of the instance is being prepared
+	InstancePreparing InstanceState = "preparing"
+
+	// InstanceStarting the instance is being booted
+	InstanceStarting InstanceState = "starting"
+
+	// InstanceRunning the instance is running
+	InstanceRunning InstanceState = "running"
+
+	// InstanceSuspending the instance is being suspended
+	InstanceSuspending InstanceState = "suspending"
+
+	// InstanceSuspended the instance is suspended
+	InstanceSuspended InstanceState = "suspended"
+
+	// InstanceStopping the instance is being stopped
+	InstanceStopping InstanceState = "stopping"
+
+	// InstanceStopped the instance is stopped
+	InstanceStopped InstanceState = "stopped"
+
+	// InstanceShutdown the instance is shut down,
+	// this is used mostly as a desired state
+	InstanceShutdown InstanceState = "shutdown"
+
+	// InstanceUnreachable the instance can't be reached
+	InstanceUnreachable InstanceState = "unreachable"
+
+	// InstanceError the instance is in an error state,
+	// the reason is in the Error_reason field
+	InstanceError InstanceState = "error"
+)
+
 // Instance represents an Oracle Compute Cloud Service
 // instance is a virtual machine running a specific
 // operating system and with CPU and memory resources that you specify.
@@ -28,75 +78,199 @@ type Instance struct {
 	Ip                              string              `json:"ip"`
 	Fingerprint                     string              `json:"fingerprint,omitempty"`
 	Site                            string              `json:"site,omitempty"`
-	Last_state_change_time          interface{}         `json:"last_state_change_time,omitempty"`
-	Error_exception                 interface{}         `json:"error_exception,omitempty"`
-	Cluster                         interface{}         `json:"cluster,omitempty"`
+	Last_state_change_time          *time.Time          `json:"last_state_change_time,omitempty"`
+	Error_exception                 string              `json:"error_exception,omitempty"`
+	Cluster                         string              `json:"cluster,omitempty"`
 	Shape                           string              `json:"shape"`
 	Start_requested                 bool                `json:"start_requested"`
-	Vethernets                      interface{}         `json:"vethernets,omitempty"`
+	Vethernets                      []string            `json:"vethernets,omitempty"`
 	Imagelist                       string              `json:"imagelist,omitempty"`
 	Image_format                    string              `json:"image_format"`
-	Cluster_uri                     interface{}         `json:"cluster_uri,omitempty"`
+	Cluster_uri                     string              `json:"cluster_uri,omitempty"`
 	Relationships                   []string            `json:"relationships,omitempty"`
-	Target_node                     interface{}         `json:"target_node,omitempty"`
-	Availability_domain             interface{}         `json:"availability_domain,omitempty"`
+	Target_node                     string              `json:"target_node,omitempty"`
+	Availability_domain             string              `json:"availability_domain,omitempty"`
 	Networking                      Networking          `json:"networking"`
-	Seclist_associations            interface{}         `json:"seclist_associations,omitempty"`
+	Seclist_associations            []string            `json:"seclist_associations,omitempty"`
 	Hostname                        string              `json:"hostname"`
-	State                           string              `json:"state"`
+	State                           InstanceState       `json:"state"`
 	Disk_attach                     string              `json:"disk_attach,omitempty"`
 	Label                           string              `json:"label,omitempty"`
 	Priority                        string              `json:"priority"`
 	Platform                        string              `json:"platform"`
-	Quota_reservation               interface{}         `json:"quota_reservation,omitempty"`
-	Suspend_file                    interface{}         `json:"suspend_file,omitempty"`
-	Node                            interface{}         `json:"node,omitempty"`
+	Quota_reservation               string              `json:"quota_reservation,omitempty"`
+	Suspend_file                    string              `json:"suspend_file,omitempty"`
+	Node                            string              `json:"node,omitempty"`
 	Resource_requirements           ResourceRequirments `json:"resource_requirements"`
-	Virtio                          interface{}         `json:"virtio,omitempty"`
+	Virtio                          bool                `json:"virtio,omitempty"`
 	Vnc                             string              `json:"vnc,omitempty"`
-	Desired_state                   string              `json:"desired_state"`
+	Desired_state                   InstanceState       `json:"desired_state"`
 	Storage_attachments             []Storage           `json:"storage_attachments,omitempty"`
-	Start_time                      string              `json:"start_time"`
-	Storage_attachment_associations []interface{}       `json:"storage_attachment_associations,omitempty"`
+	Start_time                      time.Time           `json:"start_time"`
+	Storage_attachment_associations []string            `json:"storage_attachment_associations,omitempty"`
 	Quota                           string              `json:"quota"`
-	Vnc_key                         interface{}         `json:"vnc_key,omitempty"`
+	Vnc_key                         string              `json:"vnc_key,omitempty"`
 	Numerical_priority              uint64              `json:"numerical_priority"`
 	Suspend_requested               bool                `json:"suspend_requested"`
 	Entry                           int                 `json:"entry"`
 	Error_reason                    string              `json:"error_reason,omitempty"`
-	Nat_associations                interface{}         `json:"nat_associations,omitempty"`
-	SSHKeys                         []string            `json:"sshkeys,omitemtpy"`
+	Nat_associations                []string            `json:"nat_associations,omitempty"`
+	SSHKeys                         []string            `json:"sshkeys,omitempty"`
 	Tags                            []string            `json:"tags,omitempty"`
-	Resolvers                       interface{}         `json:"resolvers,omitempty"`
-	Metrics                         interface{}         `json:"metrics,omitempty"`
+	Resolvers                       []string            `json:"resolvers,omitempty"`
+	Metrics                         json.RawMessage     `json:"metrics,omitempty"`
 	Account                         string              `json:"account"`
-	Node_uuid                       interface{}         `json:"node_uuid,omitempty"`
+	Node_uuid                       string              `json:"node_uuid,omitempty"`
 	Name                            string              `json:"name"`
-	Vcanble_id                      interface{}         `json:"vcable_id,omitempty"`
-	Higgs                           interface{}         `json:"higgs,omitempty"`
+	Vcable_id                       string              `json:"vcable_id,omitempty"`
+	Higgs                           string              `json:"higgs,omitempty"`
 	Hypervisor                      Hypervisor          `json:"hypervisor"`
 	Uri                             string              `json:"uri"`
-	Console                         interface{}         `json:"console,omitempty"`
+	Console                         string              `json:"console,omitempty"`
 	Reverse_dns                     bool                `json:"reverse_dns"`
 	Launch_context                  string              `json:"launch_context"`
-	Delete_requested                interface{}         `json:"delete_requested,omitempty"`
-	Tracking_id                     interface{}         `json:"tracking_id,omitempty"`
-	Hypervisor_type                 interface{}         `json:"hypervisor_type,omitempty"`
+	Delete_requested                *time.Time          `json:"delete_requested,omitempty"`
+	Tracking_id                     string              `json:"tracking_id,omitempty"`
+	Hypervisor_type                 string              `json:"hypervisor_type,omitempty"`
 	Attributes                      Attributes          `json:"attributes"`
 	Boot_order                      []int               `json:"boot_order,omitempty"`
-	Last_seen                       interface{}         `json:"last_seen,omitempty"`
+	Last_seen                       *time.Time          `json:"last_seen,omitempty"`
 }
 
+// Networking holds the network interfaces of an instance.
+// Instances created using Oracle-provided images support
+// up to eight interfaces, from eth0 to eth7, each of them
+// being attached to the shared network or to an ip network.
 type Networking struct {
-	Eth0 Nic `json:"eth0"`
+	Eth0 *Nic `json:"eth0,omitempty"`
+	Eth1 *Nic `json:"eth1,omitempty"`
+	Eth2 *Nic `json:"eth2,omitempty"`
+	Eth3 *Nic `json:"eth3,omitempty"`
+	Eth4 *Nic `json:"eth4,omitempty"`
+	Eth5 *Nic `json:"eth5,omitempty"`
+	Eth6 *Nic `json:"eth6,omitempty"`
+	Eth7 *Nic `json:"eth7,omitempty"`
 }
 
+// Interfaces returns all the network interfaces
+// that are set, keyed by their name, eth0 to eth7
+func (n Networking) Interfaces() map[string]*Nic {
+	interfaces := make(map[string]*Nic)
+	for key, nic := range []*Nic{
+		n.Eth0, n.Eth1, n.Eth2, n.Eth3,
+		n.Eth4, n.Eth5, n.Eth6, n.Eth7,
+	} {
+		if nic != nil {
+			interfaces[fmt.Sprintf("eth%d", key)] = nic
+		}
+	}
+	return interfaces
+}
+
+// Nic is a network interface of an instance.
+// An interface is attached either to the shared network,
+// using the vethernet, seclists and nat fields, or to an
+// ip network, using the ipnetwork, ip, address, vnic, vnicsets,
+// is_default_gateway, name_servers and search_domains fields.
 type Nic struct {
-	Model     string      `json:"model,omitempty"`
-	Seclists  []string    `json:"seclists"`
-	Dns       []string    `json:"dns"`
-	Nat       interface{} `json:"nat,omitempty"`
-	Vethernet string      `json:"vethernet"`
+	// Model is the model of the interface, the only supported value is e1000
+	Model string `json:"model,omitempty"`
+
+	// Vethernet is the vethernet of the shared network,
+	// usually /oracle/public/default
+	Vethernet string `json:"vethernet,omitempty"`
+
+	// Seclists is the list of security lists that the
+	// interface of the shared network is added to.
+	// Only eth0 could be added to security lists.
+	Seclists []string `json:"seclists,omitempty"`
+
+	// Dns is the list of dns A record names of the interface
+	Dns []string `json:"dns,omitempty"`
+
+	// Nat is the public ip that is associated with the interface.
+	// For the shared network it's ippool:/oracle/public/ippool or
+	// ipreservation:ipreservation_name and for ip networks it's
+	// a list of network/v1/ipreservation:ipreservation_name entries.
+	Nat Nat `json:"nat,omitempty"`
+
+	// Ipnetwork is the ip network that the interface is attached to
+	Ipnetwork string `json:"ipnetwork,omitempty"`
+
+	// Ip is the static ip address of the interface in the ip network.
+	// If it's empty an ip address is dynamically allocated.
+	Ip string `json:"ip,omitempty"`
+
+	// Address is the MAC address of the interface
+	Address string `json:"address,omitempty"`
+
+	// Vnic is the name of the vnic of the interface
+	Vnic string `json:"vnic,omitempty"`
+
+	// Vnicsets is the list of vnic sets that the vnic is added to
+	Vnicsets []string `json:"vnicsets,omitempty"`
+
+	// Is_default_gateway if it's true the interface is
+	// the default gateway of the instance
+	Is_default_gateway bool `json:"is_default_gateway,omitempty"`
+
+	// Name_servers is the list of name servers of the interface
+	Name_servers []string `json:"name_servers,omitempty"`
+
+	// Search_domains is the list of search domains of the interface
+	Search_domains []string `json:"search_domains,omitempty"`
+}
+
+// Nat holds the public ip addresses that are associated with an interface.
+// The shared network uses a single string value like ippool:/oracle/public/ippool
+// and ip networks use a list of values, so Nat is decoded from both
+// forms and it's encoded back in the form that the api expects.
+type Nat []string
+
+// MarshalJSON encodes a single shared network value
+// as a string and ip network values as a list
+func (n Nat) MarshalJSON() ([]byte, error) {
+	if n == nil {
+		return []byte("null"), nil
+	}
+
+	if len(n) == 1 &&
+		(strings.HasPrefix(n[0], "ippool:") ||
+			strings.HasPrefix(n[0], "ipreservation:")) {
+		return json.Marshal(n[0])
+	}
+
+	return json.Marshal([]string(n))
+}
+
+// UnmarshalJSON decodes the nat field from
+// a string, a list of strings or null
+func (n *Nat) UnmarshalJSON(b []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*n = nil
+	case string:
+		*n = Nat{v}
+	case []interface{}:
+		list := make(Nat, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("go-oracle-cloud: Invalid nat value %v", item)
+			}
+			list = append(list, s)
+		}
+		*n = list
+	default:
+		return fmt.Errorf("go-oracle-cloud: Invalid nat value %v", v)
+	}
+
+	return nil
 }
 
 type Storage struct {
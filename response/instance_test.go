@@ -0,0 +1,129 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hoenirvili/go-oracle-cloud/response"
+	gc "gopkg.in/check.v1"
+)
+
+type instanceTest struct{}
+
+var _ = gc.Suite(&instanceTest{})
+
+// decodeFixture decodes the api payload from the testdata
+// directory into v. The payloads are synthetic, written after
+// the examples of the api documentation, not captured from
+// a live account, see testdata/README.md.
+func decodeFixture(c *gc.C, name string, v interface{}) {
+	f, err := os.Open(filepath.Join("testdata", name))
+	c.Assert(err, gc.IsNil)
+	defer f.Close()
+
+	err = json.NewDecoder(f).Decode(v)
+	c.Assert(err, gc.IsNil)
+}
+
+func (i instanceTest) TestDecodeInstance(c *gc.C) {
+	var instance response.Instance
+	decodeFixture(c, "instance.json", &instance)
+
+	c.Assert(instance.State, gc.Equals, response.InstanceRunning)
+	c.Assert(instance.Desired_state, gc.Equals, response.InstanceRunning)
+	c.Assert(instance.Start_time, gc.DeepEquals,
+		time.Date(2017, 3, 14, 10, 5, 51, 0, time.UTC))
+	c.Assert(*instance.Last_state_change_time, gc.DeepEquals,
+		time.Date(2017, 3, 14, 10, 7, 5, 0, time.UTC))
+	c.Assert(*instance.Last_seen, gc.DeepEquals,
+		time.Date(2017, 3, 14, 10, 35, 4, 0, time.UTC))
+	c.Assert(instance.Delete_requested, gc.IsNil)
+	c.Assert(instance.Error_exception, gc.Equals, "")
+	c.Assert(instance.Vnc_key, gc.Equals, "")
+	c.Assert(instance.Console, gc.Equals, "")
+	c.Assert(instance.Vethernets, gc.IsNil)
+	c.Assert(instance.Availability_domain, gc.Equals, "/uscom-central-1a")
+	c.Assert(instance.Vcable_id, gc.Equals,
+		"/Compute-acme/jack.jones@example.com/016e75e7-e911-42d1-bfe1-6a7f1b3f7908")
+	c.Assert(instance.Resource_requirements.Cpus, gc.Equals, 2.0)
+	c.Assert(instance.Resource_requirements.Ram, gc.Equals, uint64(7680))
+	c.Assert(instance.Storage_attachments, gc.HasLen, 1)
+	c.Assert(instance.Storage_attachments[0].Index, gc.Equals, uint64(1))
+
+	eth0 := instance.Networking.Eth0
+	c.Assert(eth0, gc.NotNil)
+	c.Assert(eth0.Vethernet, gc.Equals, "/oracle/public/default")
+	c.Assert(eth0.Seclists, gc.HasLen, 2)
+	c.Assert(eth0.Nat, gc.DeepEquals,
+		response.Nat{"ippool:/oracle/public/ippool"})
+
+	eth1 := instance.Networking.Eth1
+	c.Assert(eth1, gc.NotNil)
+	c.Assert(eth1.Ip, gc.Equals, "192.168.1.3")
+	c.Assert(eth1.Vnicsets, gc.DeepEquals,
+		[]string{"/Compute-acme/jack.jones@example.com/vnicset1"})
+	c.Assert(eth1.Nat, gc.DeepEquals, response.Nat{
+		"network/v1/ipreservation:/Compute-acme/jack.jones@example.com/ipres1",
+	})
+	c.Assert(instance.Networking.Interfaces(), gc.HasLen, 2)
+
+	vcable := instance.Attributes.Network.Vcable_eth0
+	c.Assert(vcable.Id, gc.Equals, instance.Vcable_id)
+	c.Assert(vcable.Address, gc.DeepEquals,
+		[]string{"c6:b0:0d:ee:e0:8a", "10.196.28.82"})
+}
+
+func (i instanceTest) TestDecodeAllInstance(c *gc.C) {
+	var all response.AllInstance
+	decodeFixture(c, "allinstance.json", &all)
+
+	c.Assert(all.Result, gc.HasLen, 2)
+
+	starting := all.Result[0]
+	c.Assert(starting.State, gc.Equals, response.InstanceStarting)
+	c.Assert(starting.Networking.Eth0.Nat, gc.IsNil)
+	c.Assert(starting.Last_seen, gc.IsNil)
+
+	failed := all.Result[1]
+	c.Assert(failed.State, gc.Equals, response.InstanceError)
+	c.Assert(failed.Error_exception, gc.Equals,
+		"Unable to allocate resources for instance")
+	c.Assert(failed.Error_reason, gc.Equals, "Insufficient resources")
+	c.Assert(failed.Networking.Interfaces(), gc.HasLen, 0)
+}
+
+func (i instanceTest) TestEncodeTimes(c *gc.C) {
+	raw, err := json.Marshal(response.Instance{})
+	c.Assert(err, gc.IsNil)
+
+	var fields map[string]interface{}
+	c.Assert(json.Unmarshal(raw, &fields), gc.IsNil)
+	c.Assert(fields["start_time"], gc.Equals, "0001-01-01T00:00:00Z")
+	for _, name := range []string{
+		"last_state_change_time", "delete_requested", "last_seen",
+	} {
+		_, ok := fields[name]
+		c.Check(ok, gc.Equals, false, gc.Commentf("field %s", name))
+	}
+}
+
+func (i instanceTest) TestEncodeNat(c *gc.C) {
+	raw, err := json.Marshal(response.Nic{
+		Nat: response.Nat{"ipreservation:/Compute-acme/jack.jones@example.com/res1"},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(raw), gc.Equals,
+		`{"nat":"ipreservation:/Compute-acme/jack.jones@example.com/res1"}`)
+
+	raw, err = json.Marshal(response.Nic{
+		Nat: response.Nat{"network/v1/ipreservation:res1"},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(raw), gc.Equals,
+		`{"nat":["network/v1/ipreservation:res1"]}`)
+}
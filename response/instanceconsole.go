@@ -0,0 +1,22 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+import "time"
+
+// InstanceConsole is the output of the serial console of
+// an instance. It's useful to debug instances that fail to boot.
+type InstanceConsole struct {
+	// Name is the name of the instance
+	Name string `json:"name"`
+
+	// Output is the serial console output of the instance
+	Output string `json:"output"`
+
+	// Timestamp is the time when the output was captured
+	Timestamp time.Time `json:"timestamp"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
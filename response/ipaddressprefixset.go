@@ -0,0 +1,31 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// IpAddressPrefixSet is a set of IPv4 addresses in CIDR format
+// that can be used as the source or destination of
+// the traffic of a security rule.
+type IpAddressPrefixSet struct {
+	// Name is the name of the ip address prefix set
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// IpAddressPrefixes is the list of CIDR IPv4 prefixes assigned in the
+	// virtual network.
+	IpAddressPrefixes []string `json:"ipAddressPrefixes"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllIpAddressPrefixSet holds all the ip address prefix sets
+// from a given account
+type AllIpAddressPrefixSet struct {
+	Result []IpAddressPrefixSet `json:"result,omitempty"`
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// IpAddressPool is a pool of public IP addresses
+// that are available for instances attached to ip networks
+type IpAddressPool string
+
+const (
+	// PublicIpPool is the pool of public ip addresses
+	// that are accessible from the internet
+	PublicIpPool IpAddressPool = "public-ippool"
+
+	// CloudIpPool is the pool of ip addresses that are
+	// accessible only from the oracle cloud services
+	CloudIpPool IpAddressPool = "cloud-ippool"
+)
+
+// IpAddressReservation is an IP address reservation for instances
+// that are attached to IP networks. It reserves a public IP address
+// from the specified IP address pool. After creating an IP address
+// reservation, you can associate it with the vNIC of an instance
+// by using an IP address association.
+type IpAddressReservation struct {
+	// Name is the name of the ip address reservation
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// IpAddress is the reserved NAT IPv4 address
+	// from the IP address pool.
+	IpAddress string `json:"ipAddress,omitempty"`
+
+	// IpAddressPool is the pool from which the ip address is reserved,
+	// public-ippool or cloud-ippool.
+	IpAddressPool IpAddressPool `json:"ipAddressPool"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllIpAddressReservation holds all the ip address reservations
+// from a given account
+type AllIpAddressReservation struct {
+	Result []IpAddressReservation `json:"result,omitempty"`
+}
@@ -0,0 +1,30 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// IpNetworkExchange is an IP network exchange. It enables
+// access between IP networks that have non-overlapping addresses,
+// so that instances on these networks can exchange packets with
+// each other without NAT. An IP network can be added to only
+// one IP network exchange, but an IP network exchange can
+// include multiple IP networks.
+type IpNetworkExchange struct {
+	// Name is the name of the ip network exchange
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllIpNetworkExchange holds all the ip network exchanges
+// from a given account
+type AllIpNetworkExchange struct {
+	Result []IpNetworkExchange `json:"result,omitempty"`
+}
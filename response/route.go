@@ -0,0 +1,43 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// Route is a route for ip networks. It specifies the IP address
+// prefix of the destination and the vNICset that the traffic
+// for that destination should be sent to. Routes are used,
+// for example, to send the traffic through a virtual appliance.
+type Route struct {
+	// Name is the name of the route
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// AdminDistance is the route's administrative distance.
+	// It can be 0, 1 or 2, where 0 is the default value.
+	// When there are two routes to the same destination,
+	// the route with the lower distance is used.
+	AdminDistance int `json:"adminDistance"`
+
+	// IpAddressPrefix is the IPv4 address prefix, in CIDR format,
+	// of the external network (external to the vNIC set)
+	// from which you want to route traffic.
+	IpAddressPrefix string `json:"ipAddressPrefix"`
+
+	// NextHopVnicSet is the name of the virtual NIC set
+	// to route matching packets to.
+	NextHopVnicSet string `json:"nextHopVnicSet"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllRoute holds all the routes
+// from a given account
+type AllRoute struct {
+	Result []Route `json:"result,omitempty"`
+}
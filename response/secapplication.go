@@ -0,0 +1,56 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// SecApplication is a security application is a protocol and
+// port range or ICMP type and code that can be referenced
+// in a security rule. Oracle Compute Cloud Service provides
+// a set of predefined security applications in the
+// /oracle/public container, like ssh, http or rdp.
+type SecApplication struct {
+	// Description is a description of the security application.
+	Description string `json:"description,omitempty"`
+
+	// Dport is the TCP or UDP destination port number.
+	// You can also specify a port range, such as 5900-5999 for TCP.
+	// This parameter isn't relevant to the icmp protocol.
+	Dport string `json:"dport,omitempty"`
+
+	// Icmpcode is the ICMP code:
+	// network, host, protocol, port, df, admin.
+	Icmpcode string `json:"icmpcode,omitempty"`
+
+	// Icmptype is the ICMP type:
+	// echo, reply, ttl, traceroute, unreachable.
+	Icmptype string `json:"icmptype,omitempty"`
+
+	// Id is the internal id of the security application
+	Id string `json:"id,omitempty"`
+
+	// Name is the name of the security application
+	Name string `json:"name"`
+
+	// Protocol is the protocol to use.
+	// The value that you specify can be either a text
+	// representation of a protocol or any unsigned 8-bit
+	// assigned protocol number in the range 0-254.
+	Protocol string `json:"protocol"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+
+	// Value1 is the ICMP type or the low port of the range
+	// as an integer, filled by the api.
+	Value1 int `json:"value1,omitempty"`
+
+	// Value2 is the ICMP code or the high port of the range
+	// as an integer, filled by the api.
+	Value2 int `json:"value2,omitempty"`
+}
+
+// AllSecApplication holds all the security applications
+// from a given container
+type AllSecApplication struct {
+	Result []SecApplication `json:"result,omitempty"`
+}
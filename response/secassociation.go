@@ -0,0 +1,31 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// SecAssociation is a link between a security list
+// and the vcable of an instance. A vcable is an attachment
+// point to a specific network interface of an instance.
+// Adding an instance to a security list is done by
+// creating a security association.
+type SecAssociation struct {
+	// Name is the name of the security association
+	Name string `json:"name"`
+
+	// Seclist is the security list that
+	// you want to associate with the instance.
+	Seclist string `json:"seclist"`
+
+	// Vcable is the vcable of the instance that you want to
+	// associate with the security list.
+	Vcable string `json:"vcable"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllSecAssociation holds all the security associations
+// from a given account
+type AllSecAssociation struct {
+	Result []SecAssociation `json:"result,omitempty"`
+}
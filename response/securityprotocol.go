@@ -0,0 +1,41 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// SecurityProtocol is a security protocol for IP networks.
+// It allows you to specify a transport protocol and the
+// source and destination ports to be used with the specified
+// protocol. It is referenced in security rules.
+type SecurityProtocol struct {
+	// Name is the name of the security protocol
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// IpProtocol is the protocol used in the data portion of the IP datagram.
+	// It can be the name of the protocol (tcp, udp, icmp, ...) or
+	// the protocol number in the range 0-254.
+	IpProtocol string `json:"ipProtocol"`
+
+	// SrcPortSet is the list of source port numbers or
+	// port ranges like 5900-5999 of the traffic.
+	SrcPortSet []string `json:"srcPortSet,omitempty"`
+
+	// DstPortSet is the list of destination port numbers or
+	// port ranges like 5900-5999 of the traffic.
+	DstPortSet []string `json:"dstPortSet,omitempty"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllSecurityProtocol holds all the security protocols
+// of ip networks from a given account
+type AllSecurityProtocol struct {
+	Result []SecurityProtocol `json:"result,omitempty"`
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// FlowDirection is the direction of the flow of traffic
+// that a security rule applies to, relative to the
+// instances in the vNICset of the rule.
+type FlowDirection string
+
+const (
+	// Ingress is the direction of the traffic that
+	// flows to the instances in the vNICset
+	Ingress FlowDirection = "ingress"
+
+	// Egress is the direction of the traffic that
+	// flows from the instances in the vNICset
+	Egress FlowDirection = "egress"
+)
+
+// SecurityRule is a security rule for IP networks. It permits
+// traffic between a source and a destination. Each security rule
+// belongs to an ACL and it's applied on the vNICsets
+// that the ACL is applied to.
+type SecurityRule struct {
+	// Name is the name of the security rule
+	Name string `json:"name"`
+
+	// Acl is the name of the acl that contains this rule.
+	Acl string `json:"acl,omitempty"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// DstIpAddressPrefixSets list of IP address prefix set names
+	// to match the packet's destination IP address.
+	DstIpAddressPrefixSets []string `json:"dstIpAddressPrefixSets,omitempty"`
+
+	// DstVnicSet is the name of virtual NIC set containing
+	// the packet's destination virtual NIC.
+	DstVnicSet string `json:"dstVnicSet,omitempty"`
+
+	// EnabledFlag toggles the rule on or off.
+	EnabledFlag bool `json:"enabledFlag"`
+
+	// FlowDirection is the direction of the flow; can be "egress" or "ingress".
+	FlowDirection FlowDirection `json:"flowDirection"`
+
+	// SecProtocols list of security protocol names to match
+	// the packet's protocol and port.
+	SecProtocols []string `json:"secProtocols,omitempty"`
+
+	// SrcIpAddressPrefixSets list of IP address prefix set names
+	// to match the packet's source IP address.
+	SrcIpAddressPrefixSets []string `json:"srcIpAddressPrefixSets,omitempty"`
+
+	// SrcVnicSet is the name of virtual NIC set containing
+	// the packet's source virtual NIC.
+	SrcVnicSet string `json:"srcVnicSet,omitempty"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllSecurityRule holds all the security rules
+// of ip networks from a given account
+type AllSecurityRule struct {
+	Result []SecurityRule `json:"result,omitempty"`
+}
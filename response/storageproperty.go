@@ -0,0 +1,25 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// StorageProperty is a storage property that describes the
+// type of a storage volume, like /oracle/public/storage/default
+// for standard volumes or /oracle/public/storage/latency for
+// volumes that need low latency and high IOPS.
+type StorageProperty struct {
+	// Description is the description of the storage property
+	Description string `json:"description,omitempty"`
+
+	// Name is the name of the storage property
+	Name string `json:"name"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllStorageProperty holds all the storage properties
+// that are available in a site
+type AllStorageProperty struct {
+	Result []StorageProperty `json:"result,omitempty"`
+}
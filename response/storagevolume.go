@@ -0,0 +1,110 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// VolumeStatus is the status of a storage volume
+type VolumeStatus string
+
+const (
+	// VolumeInitializing the volume is being created
+	VolumeInitializing VolumeStatus = "Initializing"
+
+	// VolumeOnline the volume is ready to be attached
+	VolumeOnline VolumeStatus = "Online"
+
+	// VolumeDeleting the volume is being deleted
+	VolumeDeleting VolumeStatus = "Deleting"
+
+	// VolumeError the volume is in an error state,
+	// the reason is in the Status_detail field
+	VolumeError VolumeStatus = "Error"
+)
+
+// StorageVolume is a storage volume is a virtual disk that
+// provides persistent block storage space for instances.
+// Storage volumes can be attached to instances when they
+// are launched or later, while they are running.
+type StorageVolume struct {
+	// Account is the default account for your identity domain
+	Account string `json:"account,omitempty"`
+
+	// Bootable is true if the volume is a bootable volume
+	Bootable bool `json:"bootable"`
+
+	// Description is the description of the storage volume
+	Description string `json:"description,omitempty"`
+
+	// Hypervisor is the hypervisor that the volume is compatible with
+	Hypervisor string `json:"hypervisor,omitempty"`
+
+	// Imagelist is the imagelist of the bootable volume
+	Imagelist string `json:"imagelist,omitempty"`
+
+	// Imagelist_entry is the imagelist entry of the bootable volume
+	Imagelist_entry int `json:"imagelist_entry,omitempty"`
+
+	// Machineimage_name is the machine image of the bootable volume
+	Machineimage_name string `json:"machineimage_name,omitempty"`
+
+	// Managed is true if the volume is managed by the system
+	Managed bool `json:"managed"`
+
+	// Name is the name of the storage volume
+	Name string `json:"name"`
+
+	// Platform is the OS platform of the bootable volume
+	Platform string `json:"platform,omitempty"`
+
+	// Properties is the storage property of the volume,
+	// like /oracle/public/storage/default
+	Properties []string `json:"properties"`
+
+	// Quota is not used
+	Quota string `json:"quota,omitempty"`
+
+	// Readonly is true if the volume is attached in read only mode
+	Readonly bool `json:"readonly"`
+
+	// Shared is true if the volume can be attached to multiple instances
+	Shared bool `json:"shared"`
+
+	// Size is the size of the volume in bytes
+	Size string `json:"size"`
+
+	// Snapshot is the snapshot the volume was created from
+	Snapshot string `json:"snapshot,omitempty"`
+
+	// Snapshot_account is the account of the snapshot
+	Snapshot_account string `json:"snapshot_account,omitempty"`
+
+	// Snapshot_id is the id of the snapshot
+	Snapshot_id string `json:"snapshot_id,omitempty"`
+
+	// Status is the current status of the volume
+	Status VolumeStatus `json:"status"`
+
+	// Status_detail is the details of the current status of the volume
+	Status_detail string `json:"status_detail,omitempty"`
+
+	// Status_timestamp is the time of the last status change
+	Status_timestamp string `json:"status_timestamp,omitempty"`
+
+	// Storage_pool is the storage pool of the volume
+	Storage_pool string `json:"storage_pool,omitempty"`
+
+	// Tags associated with the volume
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+
+	// Writecache is not used
+	Writecache bool `json:"writecache"`
+}
+
+// AllStorageVolume holds all the storage volumes
+// from a given account
+type AllStorageVolume struct {
+	Result []StorageVolume `json:"result,omitempty"`
+}
@@ -0,0 +1,35 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package response
+
+// VnicSet is a virtual NIC set or vNICset, is a collection
+// of one or more vNICs. ACLs are applied to vNICsets and
+// routes use vNICsets as the next hop for the traffic.
+// Every vNIC is automatically added to the default vNICset,
+// when the instance that it belongs to is created.
+type VnicSet struct {
+	// Name is the name of the vnic set
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// AppliedAcls is the list of ACLs applied to the VNICs in the set.
+	AppliedAcls []string `json:"appliedAcls,omitempty"`
+
+	// Vnics is the list of VNICs associated with this VNIC set.
+	Vnics []string `json:"vnics,omitempty"`
+
+	// Tags associated with the object.
+	Tags []string `json:"tags,omitempty"`
+
+	// Uri is the Uniform Resource Identifier
+	Uri string `json:"uri"`
+}
+
+// AllVnicSet holds all the vnic sets
+// from a given account
+type AllVnicSet struct {
+	Result []VnicSet `json:"result,omitempty"`
+}